@@ -0,0 +1,241 @@
+package resellerclub
+
+import (
+	"container/list"
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores the responses of read-only calls. It must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored for key, if it has not expired.
+	Get(key string) ([]byte, bool)
+
+	// Set stores value for key during ttl.
+	Set(key string, value []byte, ttl time.Duration)
+
+	// Delete removes the value stored for key.
+	Delete(key string)
+
+	// DeletePrefix removes the values stored for every key starting with prefix.
+	DeletePrefix(prefix string)
+}
+
+// CacheTTL defines for how long the responses of each read-only call are cached.
+// A zero value disables the cache for that call.
+type CacheTTL struct {
+	OrderDetails time.Duration // Domains.GetOrderDetails, per order ID and options
+	OrderID      time.Duration // Domains.GetOrderID, per domain name
+	Availability time.Duration // Domains.CheckAvailability, per domain name
+}
+
+// DefaultCacheTTL is the CacheTTL used by WithCache when none is given.
+var DefaultCacheTTL = CacheTTL{
+	OrderDetails: time.Minute,
+	OrderID:      10 * time.Minute,
+	Availability: 30 * time.Second,
+}
+
+// WithCache caches the responses of read-only calls in cache, during the given ttl,
+// or DefaultCacheTTL if none is given. Register and Renew invalidate the affected entries.
+// The keys are scoped to the endpoint and the reseller ID, so cache may be shared by several Clients.
+func WithCache(cache Cache, ttl ...CacheTTL) Option {
+	return func(client *Client) {
+		client.cache = cache
+		client.cacheTTL = DefaultCacheTTL
+		if len(ttl) > 0 {
+			client.cacheTTL = ttl[0]
+		}
+	}
+}
+
+// WithMemoryCache caches the responses of read-only calls in a MemoryCache of up to maxEntries entries.
+// Every Client the option is applied to gets its own MemoryCache; use WithCache to share one.
+func WithMemoryCache(maxEntries int, ttl ...CacheTTL) Option {
+	return func(client *Client) {
+		WithCache(NewMemoryCache(maxEntries), ttl...)(client)
+	}
+}
+
+// cacheKey scopes key to the endpoint and the reseller of the Client,
+// so that a Cache shared by several Clients never serves the responses of one account to another.
+func (client *Client) cacheKey(key string) string {
+	return client.endpoint + "|" + client.Credentials().UserID + "|" + key
+}
+
+func orderDetailsCacheKey(orderID int64, options []OrderDetailsOption) string {
+	opts := make([]string, len(options))
+	for i, opt := range options {
+		opts[i] = string(opt)
+	}
+	sort.Strings(opts)
+
+	return orderCacheKeyPrefix(orderID) + strings.Join(opts, ",")
+}
+
+func orderCacheKeyPrefix(orderID int64) string {
+	return "details:" + strconv.FormatInt(orderID, 10) + ":"
+}
+
+func orderIDCacheKey(domainName string) string {
+	return "orderid:" + strings.ToLower(domainName)
+}
+
+func availabilityCacheKey(domainName string) string {
+	return "available:" + strings.ToLower(domainName)
+}
+
+// cachedCall is like call but serves the body from the cache of the Client when possible.
+func (client *Client) cachedCall(ctx context.Context, method string, u *url.URL, key string, ttl time.Duration) ([]byte, error) {
+	if client.cache == nil || ttl <= 0 {
+		return client.call(ctx, method, u)
+	}
+
+	key = client.cacheKey(key)
+	if body, ok := client.cache.Get(key); ok {
+		return body, nil
+	}
+
+	body, err := client.call(ctx, method, u)
+	if err != nil {
+		return nil, err
+	}
+
+	client.cache.Set(key, body, ttl)
+
+	return body, nil
+}
+
+func (client *Client) getCached(ctx context.Context, u *url.URL, key string, ttl time.Duration, target errorChecker) error {
+	body, err := client.cachedCall(ctx, http.MethodGet, u, key, ttl)
+	if err != nil {
+		return err
+	}
+
+	return client.decode(body, target)
+}
+
+func (client *Client) getInt64Cached(ctx context.Context, u *url.URL, key string, ttl time.Duration) (int64, error) {
+	body, err := client.cachedCall(ctx, http.MethodGet, u, key, ttl)
+	if err != nil {
+		return 0, err
+	}
+
+	return client.decodeInt64(body)
+}
+
+// invalidateDomain removes the cached responses about a domain name.
+func (client *Client) invalidateDomain(domainName string) {
+	if client.cache == nil {
+		return
+	}
+
+	client.cache.Delete(client.cacheKey(orderIDCacheKey(domainName)))
+	client.cache.Delete(client.cacheKey(availabilityCacheKey(domainName)))
+}
+
+// invalidateOrder removes the cached responses about an order.
+func (client *Client) invalidateOrder(orderID int64) {
+	if client.cache == nil || orderID <= 0 {
+		return
+	}
+
+	client.cache.DeletePrefix(client.cacheKey(orderCacheKeyPrefix(orderID)))
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used entries.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List
+}
+
+type memoryCacheEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache returns a MemoryCache holding up to maxEntries entries. Zero or negative means no limit.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		lru:        list.New(),
+	}
+}
+
+// Get implements Cache.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*memoryCacheEntry)
+	if time.Now().After(entry.expires) {
+		c.remove(el)
+		return nil, false
+	}
+
+	c.lru.MoveToFront(el)
+
+	return entry.value, true
+}
+
+// Set implements Cache.
+func (c *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+
+	c.entries[key] = c.lru.PushFront(&memoryCacheEntry{
+		key:     key,
+		value:   value,
+		expires: time.Now().Add(ttl),
+	})
+
+	for c.maxEntries > 0 && c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// Delete implements Cache.
+func (c *MemoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+}
+
+// DeletePrefix implements Cache.
+func (c *MemoryCache) DeletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, el := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			c.remove(el)
+		}
+	}
+}
+
+func (c *MemoryCache) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*memoryCacheEntry).key)
+}
@@ -0,0 +1,49 @@
+package resellerclub_test
+
+import (
+	"testing"
+
+	"github.com/saulortega/resellerclub"
+	"github.com/saulortega/resellerclub/resellerclubtest"
+)
+
+func TestSharedCacheIsScopedToAccount(t *testing.T) {
+	a, b := resellerclubtest.NewServer(), resellerclubtest.NewServer()
+	defer a.Close()
+	defer b.Close()
+	b.UserID = "654321"
+
+	idA := a.AddOrder(resellerclubtest.Order{DomainName: "owner-a.com"})
+	idB := b.AddOrder(resellerclubtest.Order{DomainName: "owner-b.com"})
+	if idA != idB {
+		t.Fatalf("order IDs differ: %v and %v", idA, idB)
+	}
+
+	cache := resellerclub.NewMemoryCache(100)
+	clientA := a.Client(resellerclub.WithCache(cache))
+	clientB := b.Client(resellerclub.WithCache(cache))
+
+	for _, tt := range []struct {
+		client *resellerclub.Client
+		want   string
+	}{
+		{clientA, "owner-a.com"},
+		{clientB, "owner-b.com"},
+		{clientA, "owner-a.com"},
+	} {
+		res, err := tt.client.Domains.GetOrderDetails(idA)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.DomainName != tt.want {
+			t.Errorf("got %v, want %v", res.DomainName, tt.want)
+		}
+	}
+
+	// The same reseller ID at another endpoint is another account too.
+	b.UserID = a.UserID
+	clientB = b.Client(resellerclub.WithCache(cache))
+	if res, err := clientB.Domains.GetOrderDetails(idB); err != nil || res.DomainName != "owner-b.com" {
+		t.Errorf("got %+v, %v, want owner-b.com", res, err)
+	}
+}
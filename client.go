@@ -1,26 +1,77 @@
 package resellerclub
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
+	"time"
 )
 
 type Client struct {
-	userID string
-	key    string
+	credentialsMu sync.RWMutex
+	credentials   CredentialsProvider
 
-	Domains   *Domains
-	Customers *Customers
+	endpoint    string
+	endpointErr error
+	httpClient  *http.Client
+	timeout     time.Duration
+	userAgent   string
+
+	postParamsInQuery bool
+
+	retryPolicy RetryPolicy
+	retryHook   func(RetryEvent)
+
+	rateLimiter          *RateLimiter
+	endpointRateLimiters map[string]*RateLimiter
+	rateLimitMode        RateLimitMode
+
+	interceptors []Interceptor
+	doer         Doer
+
+	logger  Logger
+	metrics Metrics
+
+	cache    Cache
+	cacheTTL CacheTTL
+
+	// Sub-services. They can be replaced by mocks in tests.
+	Domains   DomainsService
+	Customers CustomersService
 }
 
-func New(userID string, key string) *Client {
+func New(userID string, key string, opts ...Option) *Client {
 	client := &Client{
-		userID: userID,
-		key:    key,
+		credentials: NewStaticCredentials(userID, key),
+		endpoint:    endpoint,
+		httpClient:  http.DefaultClient,
+		retryPolicy: DefaultRetryPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	if client.timeout > 0 {
+		httpClient := *client.httpClient
+		httpClient.Timeout = client.timeout
+		client.httpClient = &httpClient
+	}
+
+	interceptors := client.interceptors
+	if client.logger != nil {
+		interceptors = append([]Interceptor{loggingInterceptor(client.logger)}, interceptors...)
+	}
+	if client.metrics != nil {
+		interceptors = append([]Interceptor{metricsInterceptor(client.metrics)}, interceptors...)
 	}
+	client.doer = chain(DoerFunc(client.transport), interceptors)
 
 	client.Domains = &Domains{client}
 	client.Customers = &Customers{client}
@@ -28,50 +79,241 @@ func New(userID string, key string) *Client {
 	return client
 }
 
+// url returns the URL of an API path. The credentials are not part of it;
+// they are added by send, after the interceptors.
 func (client *Client) url(path string) *url.URL {
-	u, _ := url.Parse(endpoint)
-	q := u.Query()
-	q.Set("auth-userid", client.userID)
-	q.Set("api-key", client.key)
+	u, _ := url.Parse(client.endpoint)
 	u.Path += path
-	u.RawQuery = q.Encode()
 
 	return u
 }
 
-func (client *Client) get(url string, target errorChecker) error {
-	resp, err := http.Get(url)
+// path returns the API path of u, relative to the endpoint.
+func (client *Client) path(u *url.URL) string {
+	base, _ := url.Parse(client.endpoint)
+	return strings.TrimPrefix(u.Path, base.Path)
+}
+
+// call passes the API call through the interceptors and returns the body of the response.
+func (client *Client) call(ctx context.Context, method string, u *url.URL) ([]byte, error) {
+	if client.endpointErr != nil {
+		return nil, client.endpointErr
+	}
+
+	req := &Request{
+		Method: method,
+		Path:   client.path(u),
+		Params: u.Query(),
+		Header: http.Header{},
+	}
+
+	res, err := client.doer.Do(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	defer resp.Body.Close()
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	if res.raw != nil {
+		return res.raw, nil
+	}
+
+	return res.Body, nil
+}
+
+// transport is the innermost Doer. It sends the API call, applying the rate limits and the RetryPolicy of the Client.
+func (client *Client) transport(ctx context.Context, req *Request) (*Response, error) {
+	idempotent := req.Method == http.MethodGet
+
+	for attempt := 1; ; attempt++ {
+		if err := client.waitRateLimit(ctx, req.Path); err != nil {
+			return nil, err
+		}
 
-	return client.request(resp, target)
+		credentials := client.Credentials()
+		secrets := append(req.secrets(), credentials.secrets()...)
+
+		var res *Response
+		resp, err := client.send(ctx, req, credentials)
+		if err == nil {
+			res, err = client.response(resp, req, secrets)
+		}
+		err = redactError(err, secrets)
+
+		failed, retry, wait := client.retryPolicy.decide(attempt, idempotent, res, err)
+		if !failed {
+			return res, nil
+		}
+
+		if client.retryHook != nil {
+			event := RetryEvent{
+				Method:  req.Method,
+				Path:    req.Path,
+				Attempt: attempt,
+				Err:     err,
+				Retry:   retry,
+				Wait:    wait,
+			}
+			if res != nil {
+				event.StatusCode = res.StatusCode
+			}
+			client.retryHook(event)
+		}
+
+		if retry && client.metrics != nil {
+			client.metrics.ObserveRetry(req.Path)
+		}
+
+		if !retry {
+			return res, err
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
 }
 
-func (client *Client) post(url string, target errorChecker) error {
-	resp, err := http.Post(url, "text/plain", nil)
+// send performs one attempt of the call, adding the credentials to its parameters.
+func (client *Client) send(ctx context.Context, req *Request, credentials Credentials) (*http.Response, error) {
+	u, _ := url.Parse(client.endpoint)
+	u.Path += req.Path
+
+	params := url.Values{}
+	for k, v := range req.Params {
+		params[k] = v
+	}
+	params.Set("auth-userid", credentials.UserID)
+	params.Set("api-key", credentials.APIKey)
+
+	var body io.Reader
+	var contentType string
+	switch {
+	case req.Method != http.MethodPost:
+		u.RawQuery = params.Encode()
+	case client.postParamsInQuery:
+		u.RawQuery = params.Encode()
+		contentType = "text/plain"
+	default:
+		body = strings.NewReader(params.Encode())
+		contentType = "application/x-www-form-urlencoded"
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, u.String(), body)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	for k, v := range req.Header {
+		httpReq.Header[k] = v
+	}
+	if len(contentType) > 0 && len(httpReq.Header.Get("Content-Type")) == 0 {
+		httpReq.Header.Set("Content-Type", contentType)
 	}
+	if len(client.userAgent) > 0 && len(httpReq.Header.Get("User-Agent")) == 0 {
+		httpReq.Header.Set("User-Agent", client.userAgent)
+	}
+
+	return client.httpClient.Do(httpReq)
+}
 
+// response reads resp. Secrets are scrubbed from the body exposed to interceptors and errors,
+// but the body decoded into the result is kept as received: a secret can be part of a legitimate value,
+// like an order ID holding the digits of the API key.
+func (client *Client) response(resp *http.Response, req *Request, secrets []string) (*Response, error) {
 	defer resp.Body.Close()
 
-	return client.request(resp, target)
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	body := redactBytes(raw, secrets)
+
+	res := &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+		Err:        decodeError(body),
+		raw:        raw,
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 || !json.Valid(body) {
+		res.Err = newHTTPError(req.Path, resp, body, res.Err)
+	}
+
+	return res, nil
 }
 
-func (client *Client) request(resp *http.Response, target errorChecker) error {
-	body, err := ioutil.ReadAll(resp.Body)
+// Do calls any API path, e.g. /domains/modify-ns.json, with the given parameters,
+// and decodes the JSON response into out, which may be nil.
+// The credentials, interceptors, rate limits and retries of the Client apply as for any other call,
+// and the errors returned by the API are reported the same way.
+func (client *Client) Do(ctx context.Context, method string, path string, params url.Values, out interface{}) error {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	u := client.url(path)
+	q := u.Query()
+	for k, v := range params {
+		if k != "auth-userid" && k != "api-key" {
+			q[k] = v
+		}
+	}
+	u.RawQuery = q.Encode()
+
+	body, err := client.call(ctx, strings.ToUpper(method), u)
+	if err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if target, ok := out.(errorChecker); ok {
+		return client.decode(body, target)
+	}
+
+	err = json.Unmarshal(body, out)
 	if err != nil {
+		if !json.Valid(body) {
+			return errors.New(string(body))
+		}
+
 		return somethingWentWrong(string(body))
 	}
 
+	return nil
+}
+
+func (client *Client) get(ctx context.Context, u *url.URL, target errorChecker) error {
+	body, err := client.call(ctx, http.MethodGet, u)
+	if err != nil {
+		return err
+	}
+
+	return client.decode(body, target)
+}
+
+func (client *Client) post(ctx context.Context, u *url.URL, target errorChecker) error {
+	body, err := client.call(ctx, http.MethodPost, u)
+	if err != nil {
+		return err
+	}
+
+	return client.decode(body, target)
+}
+
+func (client *Client) decode(body []byte, target errorChecker) error {
 	if len(body) > 0 && body[0] != '{' {
 		return errors.New(string(body))
 	}
 
-	err = json.Unmarshal(body, target)
+	err := json.Unmarshal(body, target)
 	if err != nil {
 		return somethingWentWrong(string(body))
 	}
@@ -83,36 +325,27 @@ func (client *Client) request(resp *http.Response, target errorChecker) error {
 	return nil
 }
 
-func (client *Client) getInt64(url string) (int64, error) {
-	resp, err := http.Get(url)
+func (client *Client) getInt64(ctx context.Context, u *url.URL) (int64, error) {
+	body, err := client.call(ctx, http.MethodGet, u)
 	if err != nil {
 		return 0, err
 	}
 
-	defer resp.Body.Close()
-
-	return client.requestInt64(resp)
+	return client.decodeInt64(body)
 }
 
-func (client *Client) postInt64(url string) (int64, error) {
-	resp, err := http.Post(url, "text/plain", nil)
+func (client *Client) postInt64(ctx context.Context, u *url.URL) (int64, error) {
+	body, err := client.call(ctx, http.MethodPost, u)
 	if err != nil {
 		return 0, err
 	}
 
-	defer resp.Body.Close()
-
-	return client.requestInt64(resp)
+	return client.decodeInt64(body)
 }
 
-func (client *Client) requestInt64(resp *http.Response) (int64, error) {
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return 0, somethingWentWrong(string(body))
-	}
-
+func (client *Client) decodeInt64(body []byte) (int64, error) {
 	var i int64
-	err = json.Unmarshal(body, &i)
+	err := json.Unmarshal(body, &i)
 	if err != nil {
 		var e errorResponse
 		err = json.Unmarshal(body, &e)
@@ -129,3 +362,41 @@ func (client *Client) requestInt64(resp *http.Response) (int64, error) {
 
 	return i, nil
 }
+
+func (client *Client) getBool(ctx context.Context, u *url.URL) (bool, error) {
+	body, err := client.call(ctx, http.MethodGet, u)
+	if err != nil {
+		return false, err
+	}
+
+	return client.decodeBool(body)
+}
+
+func (client *Client) postBool(ctx context.Context, u *url.URL) (bool, error) {
+	body, err := client.call(ctx, http.MethodPost, u)
+	if err != nil {
+		return false, err
+	}
+
+	return client.decodeBool(body)
+}
+
+func (client *Client) decodeBool(body []byte) (bool, error) {
+	var b Bool
+	err := json.Unmarshal(body, &b)
+	if err != nil {
+		var e errorResponse
+		err = json.Unmarshal(body, &e)
+		if err != nil {
+			return false, somethingWentWrong(string(body))
+		}
+
+		if err = e.Err(); err != nil {
+			return false, err
+		}
+
+		return false, somethingWentWrong(string(body))
+	}
+
+	return bool(b), nil
+}
@@ -0,0 +1,50 @@
+package resellerclub_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/saulortega/resellerclub"
+	"github.com/saulortega/resellerclub/resellerclubtest"
+)
+
+func TestRetries(t *testing.T) {
+	s := resellerclubtest.NewServer()
+	defer s.Close()
+	id := s.AddOrder(resellerclubtest.Order{DomainName: "example.com"})
+
+	var events []resellerclub.RetryEvent
+	client := s.Client(
+		resellerclub.WithRetryPolicy(resellerclub.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}),
+		resellerclub.WithRetryHook(func(e resellerclub.RetryEvent) {
+			events = append(events, e)
+		}),
+	)
+
+	t.Run("GET is retried", func(t *testing.T) {
+		events = nil
+		s.FailNext("/domains/details.json", http.StatusServiceUnavailable, "Service Unavailable")
+		s.FailNext("/domains/details.json", http.StatusBadGateway, "Bad Gateway")
+
+		if _, err := client.Domains.GetOrderDetails(id); err != nil {
+			t.Fatal(err)
+		}
+		if len(events) != 2 || !events[0].Retry || !events[1].Retry || events[1].Attempt != 2 {
+			t.Errorf("got events %+v", events)
+		}
+	})
+
+	t.Run("POST is not retried", func(t *testing.T) {
+		events = nil
+		s.FailNext("/domains/renew.json", http.StatusServiceUnavailable, "Service Unavailable")
+
+		_, err := client.Domains.Renew(&resellerclub.DomainRenewParams{OrderID: id, Years: 1, InvoiceOption: "NoInvoice"})
+		if err == nil {
+			t.Fatal("got no error")
+		}
+		if len(events) != 1 || events[0].Retry || events[0].StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("got events %+v", events)
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package resellerclub_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/saulortega/resellerclub"
+)
+
+// sentRequest is what an API call put in the URL and in the body.
+type sentRequest struct {
+	contentType string
+	query       url.Values
+	body        url.Values
+}
+
+func TestPostParamsInBody(t *testing.T) {
+	sent := map[string]sentRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		body, err := url.ParseQuery(string(data))
+		if err != nil {
+			t.Error(err)
+		}
+
+		sent[r.URL.Path] = sentRequest{r.Header.Get("Content-Type"), r.URL.Query(), body}
+
+		if r.URL.Path == "/api/customers/v2/signup.json" {
+			w.Write([]byte(`1234`))
+			return
+		}
+		w.Write([]byte(`{"entityid":"1","actionstatus":"Success"}`))
+	}))
+	defer server.Close()
+
+	calls := func(opts ...resellerclub.Option) {
+		t.Helper()
+
+		client := resellerclub.New("123456", "secret-api-key", append(opts, resellerclub.WithEndpoint(server.URL+"/api"))...)
+
+		if _, err := client.Customers.Create(&resellerclub.CustomerCreateParams{
+			Username: "jane@example.com",
+			Password: "s3cret-passwd",
+			Name:     "Jane",
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := client.Domains.Register(&resellerclub.DomainRegisterParams{
+			DomainName:       "example.com",
+			Years:            1,
+			NS:               []string{"ns1.example.net", "ns2.example.net"},
+			CustomerID:       1,
+			RegContactID:     1,
+			AdminContactID:   1,
+			TechContactID:    1,
+			BillingContactID: 1,
+			InvoiceOption:    "NoInvoice",
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	secrets := []string{"auth-userid", "api-key", "passwd"}
+	paths := []string{"/api/customers/v2/signup.json", "/api/domains/register.json"}
+
+	calls()
+	for _, path := range paths {
+		req := sent[path]
+		if req.contentType != "application/x-www-form-urlencoded" {
+			t.Errorf("%v: got Content-Type %q", path, req.contentType)
+		}
+		for _, name := range secrets {
+			if _, ok := req.query[name]; ok {
+				t.Errorf("%v: %v is in the query string", path, name)
+			}
+		}
+		if req.body.Get("api-key") != "secret-api-key" || req.body.Get("auth-userid") != "123456" {
+			t.Errorf("%v: got no credentials in the body: %v", path, req.body)
+		}
+	}
+	if got := sent[paths[0]].body.Get("passwd"); got != "s3cret-passwd" {
+		t.Errorf("got passwd %q in the body", got)
+	}
+
+	calls(resellerclub.WithPostParamsInQuery())
+	for _, path := range paths {
+		req := sent[path]
+		if req.contentType != "text/plain" {
+			t.Errorf("WithPostParamsInQuery: %v: got Content-Type %q", path, req.contentType)
+		}
+		if req.query.Get("api-key") != "secret-api-key" || req.query.Get("auth-userid") != "123456" {
+			t.Errorf("WithPostParamsInQuery: %v: got no credentials in the query string: %v", path, req.query)
+		}
+		if len(req.body) > 0 {
+			t.Errorf("WithPostParamsInQuery: %v: got a body: %v", path, req.body)
+		}
+	}
+	if got := sent[paths[0]].query.Get("passwd"); got != "s3cret-passwd" {
+		t.Errorf("WithPostParamsInQuery: got passwd %q in the query string", got)
+	}
+}
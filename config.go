@@ -0,0 +1,273 @@
+package resellerclub
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Environment variables read by NewFromEnv and the profile loader.
+const (
+	EnvUserID   = "RESELLERCLUB_USER_ID"
+	EnvAPIKey   = "RESELLERCLUB_API_KEY"
+	EnvEndpoint = "RESELLERCLUB_ENDPOINT"
+	EnvConfig   = "RESELLERCLUB_CONFIG"
+	EnvProfile  = "RESELLERCLUB_PROFILE"
+)
+
+var (
+	ErrMissingCredentials   = errors.New("missing reseller ID or API key")
+	ErrTestModeWithEndpoint = errors.New("test mode and endpoint are both set")
+)
+
+// NewFromEnv creates a Client with the credentials in RESELLERCLUB_USER_ID and RESELLERCLUB_API_KEY,
+// and the endpoint in RESELLERCLUB_ENDPOINT, if set. The given options are applied after those.
+// An invalid endpoint is reported as ErrInvalidEndpoint.
+func NewFromEnv(opts ...Option) (*Client, error) {
+	userID := os.Getenv(EnvUserID)
+	key := os.Getenv(EnvAPIKey)
+	if len(userID) == 0 || len(key) == 0 {
+		return nil, ErrMissingCredentials
+	}
+
+	if e := os.Getenv(EnvEndpoint); len(e) > 0 {
+		opts = append([]Option{WithEndpoint(e)}, opts...)
+	}
+
+	return newChecked(userID, key, opts...)
+}
+
+// newChecked is like New but reports the configuration errors of the options, like an invalid endpoint.
+func newChecked(userID string, key string, opts ...Option) (*Client, error) {
+	client := New(userID, key, opts...)
+	if client.endpointErr != nil {
+		return nil, client.endpointErr
+	}
+
+	return client, nil
+}
+
+// Profile holds the credentials and settings of a Client, e.g. for production,
+// the test environment or a sub-reseller.
+type Profile struct {
+	UserID string
+
+	// API key, or the name of an environment variable holding it with APIKeyEnv, to keep it out of the file.
+	APIKey    string
+	APIKeyEnv string
+
+	Endpoint  string
+	TestMode  bool
+	Timeout   time.Duration
+	UserAgent string
+
+	// Optional. Limit of the calls of the Client.
+	RateLimit *RateLimit
+
+	// Optional. Limits of the calls to specific API paths, e.g. /domains/available.json.
+	EndpointRateLimits map[string]RateLimit
+
+	// Optional. Maximum number of attempts of each call. Zero means DefaultRetryPolicy.
+	MaxAttempts int
+}
+
+// Config is a set of named profiles, as stored in a JSON file:
+//
+//	{
+//		"default": "production",
+//		"profiles": {
+//			"production": {
+//				"user_id": "123456",
+//				"api_key_env": "RESELLERCLUB_PRODUCTION_API_KEY",
+//				"timeout": "30s",
+//				"rate_limit": {"rate": 5, "burst": 10},
+//				"endpoint_rate_limits": {"/domains/available.json": {"rate": 1, "burst": 1}}
+//			},
+//			"ote": {
+//				"user_id": "654321",
+//				"api_key": "asdfghjklqwertyuiopzxcvbnm",
+//				"test_mode": true
+//			}
+//		}
+//	}
+type Config struct {
+	// Name of the profile used when none is given.
+	Default string
+
+	Profiles map[string]Profile
+}
+
+type configJSON struct {
+	Default  string                 `json:"default"`
+	Profiles map[string]profileJSON `json:"profiles"`
+}
+
+type profileJSON struct {
+	UserID             string               `json:"user_id"`
+	APIKey             string               `json:"api_key"`
+	APIKeyEnv          string               `json:"api_key_env"`
+	Endpoint           string               `json:"endpoint"`
+	TestMode           bool                 `json:"test_mode"`
+	Timeout            string               `json:"timeout"`
+	UserAgent          string               `json:"user_agent"`
+	RateLimit          *RateLimit           `json:"rate_limit"`
+	EndpointRateLimits map[string]RateLimit `json:"endpoint_rate_limits"`
+	MaxAttempts        int                  `json:"max_attempts"`
+}
+
+// DefaultConfigPath returns the path of the profiles file: RESELLERCLUB_CONFIG if set,
+// or .resellerclub/config.json in the home directory.
+func DefaultConfigPath() string {
+	if path := os.Getenv(EnvConfig); len(path) > 0 {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".resellerclub", "config.json")
+}
+
+// LoadConfig reads a profiles file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw configJSON
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid config file %v: %v", path, err)
+	}
+
+	config := &Config{
+		Default:  raw.Default,
+		Profiles: map[string]Profile{},
+	}
+
+	for name, p := range raw.Profiles {
+		profile := Profile{
+			UserID:             p.UserID,
+			APIKey:             p.APIKey,
+			APIKeyEnv:          p.APIKeyEnv,
+			Endpoint:           p.Endpoint,
+			TestMode:           p.TestMode,
+			UserAgent:          p.UserAgent,
+			RateLimit:          p.RateLimit,
+			EndpointRateLimits: p.EndpointRateLimits,
+			MaxAttempts:        p.MaxAttempts,
+		}
+
+		if len(p.Timeout) > 0 {
+			profile.Timeout, err = time.ParseDuration(p.Timeout)
+			if err != nil {
+				return nil, fmt.Errorf("invalid timeout of profile %v: %v", name, err)
+			}
+		}
+
+		if err = profile.validate(); err != nil {
+			return nil, fmt.Errorf("invalid profile %v: %w", name, err)
+		}
+
+		config.Profiles[name] = profile
+	}
+
+	return config, nil
+}
+
+// Client creates a Client for the named profile, or the default one if name is empty.
+// The given options are applied after those of the profile.
+func (config *Config) Client(name string, opts ...Option) (*Client, error) {
+	if len(name) == 0 {
+		name = config.Default
+	}
+
+	profile, ok := config.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found", name)
+	}
+
+	return profile.Client(opts...)
+}
+
+// Client creates a Client for the profile. The given options are applied after those of the profile.
+// An invalid endpoint is reported as ErrInvalidEndpoint, and a profile with both TestMode
+// and Endpoint as ErrTestModeWithEndpoint.
+func (profile Profile) Client(opts ...Option) (*Client, error) {
+	if err := profile.validate(); err != nil {
+		return nil, err
+	}
+
+	key := profile.APIKey
+	if len(profile.APIKeyEnv) > 0 {
+		key = os.Getenv(profile.APIKeyEnv)
+	}
+
+	if len(profile.UserID) == 0 || len(key) == 0 {
+		return nil, ErrMissingCredentials
+	}
+
+	return newChecked(profile.UserID, key, append(profile.Options(), opts...)...)
+}
+
+// validate checks that the settings of the profile are consistent. A test mode profile with an endpoint
+// could point to production, so it is refused rather than one of them silently winning.
+func (profile Profile) validate() error {
+	if profile.TestMode && len(profile.Endpoint) > 0 {
+		return ErrTestModeWithEndpoint
+	}
+
+	return nil
+}
+
+// Options returns the options of the settings of the profile.
+func (profile Profile) Options() []Option {
+	var opts []Option
+
+	if profile.TestMode {
+		opts = append(opts, WithTestMode())
+	}
+	if len(profile.Endpoint) > 0 {
+		opts = append(opts, WithEndpoint(profile.Endpoint))
+	}
+	if profile.Timeout > 0 {
+		opts = append(opts, WithTimeout(profile.Timeout))
+	}
+	if len(profile.UserAgent) > 0 {
+		opts = append(opts, WithUserAgent(profile.UserAgent))
+	}
+	if profile.RateLimit != nil {
+		opts = append(opts, WithRateLimit(*profile.RateLimit))
+	}
+	for path, limit := range profile.EndpointRateLimits {
+		opts = append(opts, WithEndpointRateLimit(path, limit))
+	}
+	if profile.MaxAttempts > 0 {
+		policy := DefaultRetryPolicy
+		policy.MaxAttempts = profile.MaxAttempts
+		opts = append(opts, WithRetryPolicy(policy))
+	}
+
+	return opts
+}
+
+// NewFromProfile creates a Client for the named profile of the file at DefaultConfigPath.
+// If name is empty, RESELLERCLUB_PROFILE or the default profile of the file is used.
+func NewFromProfile(name string, opts ...Option) (*Client, error) {
+	config, err := LoadConfig(DefaultConfigPath())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(name) == 0 {
+		name = os.Getenv(EnvProfile)
+	}
+
+	return config.Client(name, opts...)
+}
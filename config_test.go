@@ -0,0 +1,141 @@
+package resellerclub
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const sampleConfig = `{
+	"default": "production",
+	"profiles": {
+		"production": {
+			"user_id": "123456",
+			"api_key_env": "RESELLERCLUB_TEST_PRODUCTION_API_KEY",
+			"endpoint": "https://httpapi.example.com/api/",
+			"timeout": "30s",
+			"user_agent": "shop/1.0",
+			"rate_limit": {"rate": 5, "burst": 10},
+			"endpoint_rate_limits": {"/domains/available.json": {"rate": 1, "burst": 2}},
+			"max_attempts": 5
+		},
+		"ote": {
+			"user_id": "654321",
+			"api_key": "asdfghjklqwertyuiopzxcvbnm",
+			"test_mode": true
+		}
+	}
+}`
+
+func writeConfig(t *testing.T, data string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func setenv(t *testing.T, key string, value string) {
+	t.Helper()
+
+	old, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+func TestLoadConfig(t *testing.T) {
+	config, err := LoadConfig(writeConfig(t, sampleConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = config.Client(""); !errors.Is(err, ErrMissingCredentials) {
+		t.Errorf("without the API key variable: got %v, want ErrMissingCredentials", err)
+	}
+
+	setenv(t, "RESELLERCLUB_TEST_PRODUCTION_API_KEY", "production-api-key")
+
+	client, err := config.Client("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := client.Credentials(); got != (Credentials{"123456", "production-api-key"}) {
+		t.Errorf("got credentials %+v", got)
+	}
+	if client.endpoint != "https://httpapi.example.com/api" {
+		t.Errorf("got endpoint %v", client.endpoint)
+	}
+	if client.httpClient.Timeout != 30*time.Second {
+		t.Errorf("got timeout %v", client.httpClient.Timeout)
+	}
+	if client.userAgent != "shop/1.0" {
+		t.Errorf("got user agent %v", client.userAgent)
+	}
+	if client.rateLimiter == nil || client.rateLimiter.limit != (RateLimit{Rate: 5, Burst: 10}) {
+		t.Errorf("got rate limiter %+v", client.rateLimiter)
+	}
+	if l := client.endpointRateLimiters["/domains/available.json"]; l == nil || l.limit != (RateLimit{Rate: 1, Burst: 2}) {
+		t.Errorf("got endpoint rate limiter %+v", l)
+	}
+	if client.retryPolicy.MaxAttempts != 5 {
+		t.Errorf("got %v attempts", client.retryPolicy.MaxAttempts)
+	}
+
+	client, err = config.Client("ote")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.endpoint != testEndpoint {
+		t.Errorf("test mode: got endpoint %v", client.endpoint)
+	}
+	if client.rateLimiter != nil || client.httpClient.Timeout != 0 {
+		t.Error("test mode profile got the settings of another profile")
+	}
+
+	if _, err = config.Client("staging"); err == nil {
+		t.Error("got no error for an unknown profile")
+	}
+}
+
+func TestNewFromProfile(t *testing.T) {
+	setenv(t, EnvConfig, writeConfig(t, sampleConfig))
+	setenv(t, EnvProfile, "ote")
+
+	client, err := NewFromProfile("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.Credentials().UserID != "654321" || client.endpoint != testEndpoint {
+		t.Errorf("got %+v at %v, want the ote profile", client.Credentials(), client.endpoint)
+	}
+}
+
+func TestTestModeWithEndpoint(t *testing.T) {
+	_, err := LoadConfig(writeConfig(t, `{"profiles": {"ote": {
+		"user_id": "654321",
+		"api_key": "asdfghjklqwertyuiopzxcvbnm",
+		"test_mode": true,
+		"endpoint": "https://httpapi.com/api"
+	}}}`))
+	if !errors.Is(err, ErrTestModeWithEndpoint) {
+		t.Errorf("LoadConfig: got %v, want ErrTestModeWithEndpoint", err)
+	}
+
+	profile := Profile{UserID: "654321", APIKey: "asdfghjklqwertyuiopzxcvbnm", TestMode: true, Endpoint: "https://httpapi.com/api"}
+	if _, err = profile.Client(); !errors.Is(err, ErrTestModeWithEndpoint) {
+		t.Errorf("Profile.Client: got %v, want ErrTestModeWithEndpoint", err)
+	}
+}
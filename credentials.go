@@ -0,0 +1,74 @@
+package resellerclub
+
+import "sync"
+
+// Credentials authenticate the API calls.
+type Credentials struct {
+	UserID string // Reseller ID
+	APIKey string
+}
+
+// CredentialsProvider supplies the credentials of a Client. It is consulted on every call,
+// so it can rotate the API key, e.g. from a secret manager. It must be safe for concurrent use.
+type CredentialsProvider interface {
+	Credentials() Credentials
+}
+
+// StaticCredentials is a CredentialsProvider whose credentials can be replaced at any time.
+type StaticCredentials struct {
+	mu          sync.RWMutex
+	credentials Credentials
+}
+
+// NewStaticCredentials returns a StaticCredentials holding the given credentials.
+func NewStaticCredentials(userID string, key string) *StaticCredentials {
+	return &StaticCredentials{
+		credentials: Credentials{userID, key},
+	}
+}
+
+// Credentials implements CredentialsProvider.
+func (s *StaticCredentials) Credentials() Credentials {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.credentials
+}
+
+// Set replaces the credentials.
+func (s *StaticCredentials) Set(userID string, key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.credentials = Credentials{userID, key}
+}
+
+// WithCredentialsProvider makes the Client get its credentials from provider on every call.
+// The reseller ID and API key given to New are then ignored.
+func WithCredentialsProvider(provider CredentialsProvider) Option {
+	return func(client *Client) {
+		if provider != nil {
+			client.credentials = provider
+		}
+	}
+}
+
+// SetCredentials replaces the credentials of the Client, and of every sub-service handed out,
+// for the calls made from now on. It is safe to call while other calls are in progress.
+// It replaces any CredentialsProvider set with WithCredentialsProvider.
+func (client *Client) SetCredentials(userID string, key string) {
+	client.credentialsMu.Lock()
+	defer client.credentialsMu.Unlock()
+
+	// A provider given with WithCredentialsProvider may be shared by other Clients, so it is never modified.
+	client.credentials = NewStaticCredentials(userID, key)
+}
+
+// Credentials returns the credentials the Client uses for the calls made now.
+func (client *Client) Credentials() Credentials {
+	client.credentialsMu.RLock()
+	provider := client.credentials
+	client.credentialsMu.RUnlock()
+
+	return provider.Credentials()
+}
@@ -0,0 +1,26 @@
+package resellerclub
+
+import "testing"
+
+func TestSetCredentialsDoesNotModifySharedProvider(t *testing.T) {
+	shared := NewStaticCredentials("123456", "shared-api-key")
+	a := New("", "", WithCredentialsProvider(shared))
+	b := New("", "", WithCredentialsProvider(shared))
+
+	a.SetCredentials("123456", "rotated-api-key")
+
+	if got := a.Credentials().APIKey; got != "rotated-api-key" {
+		t.Errorf("a: got API key %q, want rotated-api-key", got)
+	}
+	if got := b.Credentials().APIKey; got != "shared-api-key" {
+		t.Errorf("b: got API key %q, want shared-api-key", got)
+	}
+	if got := shared.Credentials().APIKey; got != "shared-api-key" {
+		t.Errorf("shared provider: got API key %q, want shared-api-key", got)
+	}
+
+	shared.Set("123456", "rotated-shared-api-key")
+	if got := b.Credentials().APIKey; got != "rotated-shared-api-key" {
+		t.Errorf("b: got API key %q, want rotated-shared-api-key", got)
+	}
+}
@@ -1,9 +1,19 @@
 package resellerclub
 
 import (
+	"context"
 	"net/url"
 )
 
+// CustomersService is the interface of the customer calls, implemented by Customers.
+// The resellerclubtest package provides a mock of it.
+type CustomersService interface {
+	Create(params *CustomerCreateParams) (int64, error)
+	CreateContext(ctx context.Context, params *CustomerCreateParams) (int64, error)
+}
+
+var _ CustomersService = (*Customers)(nil)
+
 type Customers struct {
 	client *Client
 }
@@ -1,6 +1,9 @@
 package resellerclub
 
-import "strconv"
+import (
+	"context"
+	"strconv"
+)
 
 type CustomerCreateParams struct {
 	// Required. Username for the Customer Account. Username should be an email address.
@@ -88,6 +91,11 @@ type CustomerCreateParams struct {
 // Create creates a new customer.
 // https://manage.resellerclub.com/kb/answer/804
 func (customers *Customers) Create(params *CustomerCreateParams) (int64, error) {
+	return customers.CreateContext(context.Background(), params)
+}
+
+// CreateContext is like Create but uses the given context for the API call.
+func (customers *Customers) CreateContext(ctx context.Context, params *CustomerCreateParams) (int64, error) {
 	if params == nil {
 		return 0, ErrMissingParams
 	}
@@ -150,5 +158,5 @@ func (customers *Customers) Create(params *CustomerCreateParams) (int64, error)
 
 	u.RawQuery = q.Encode()
 
-	return customers.client.postInt64(u.String())
+	return customers.client.postInt64(ctx, u)
 }
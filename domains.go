@@ -1,9 +1,51 @@
 package resellerclub
 
 import (
+	"context"
 	"net/url"
 )
 
+// DomainsService is the interface of the domain calls, implemented by Domains.
+// The resellerclubtest package provides a mock of it.
+type DomainsService interface {
+	CheckAvailability(domainNames []string, tlds []string) ([]*DomainAvailabilityResponse, error)
+	CheckAvailabilityContext(ctx context.Context, domainNames []string, tlds []string) ([]*DomainAvailabilityResponse, error)
+	GetOrderID(domainName string) (int64, error)
+	GetOrderIDContext(ctx context.Context, domainName string) (int64, error)
+	GetOrderDetails(orderID int64, options ...OrderDetailsOption) (*DomainGetOrderDetailsResponse, error)
+	GetOrderDetailsContext(ctx context.Context, orderID int64, options ...OrderDetailsOption) (*DomainGetOrderDetailsResponse, error)
+	Search(params *DomainSearchParams) ([]*DomainSearchResponseItem, error)
+	SearchContext(ctx context.Context, params *DomainSearchParams) ([]*DomainSearchResponseItem, error)
+	Register(params *DomainRegisterParams) (*DomainRegisterResponse, error)
+	RegisterContext(ctx context.Context, params *DomainRegisterParams) (*DomainRegisterResponse, error)
+	Renew(params *DomainRenewParams) (*DomainRenewResponse, error)
+	RenewContext(ctx context.Context, params *DomainRenewParams) (*DomainRenewResponse, error)
+	Transfer(params *DomainTransferParams) (*DomainTransferResponse, error)
+	TransferContext(ctx context.Context, params *DomainTransferParams) (*DomainTransferResponse, error)
+	ValidateTransfer(domainName string) (bool, error)
+	ValidateTransferContext(ctx context.Context, domainName string) (bool, error)
+	CancelTransfer(orderID int64) (*DomainCancelTransferResponse, error)
+	CancelTransferContext(ctx context.Context, orderID int64) (*DomainCancelTransferResponse, error)
+	ResendTransferApprovalMail(orderID int64) (bool, error)
+	ResendTransferApprovalMailContext(ctx context.Context, orderID int64) (bool, error)
+	SubmitAuthCode(orderID int64, authCode string) (*DomainSubmitAuthCodeResponse, error)
+	SubmitAuthCodeContext(ctx context.Context, orderID int64, authCode string) (*DomainSubmitAuthCodeResponse, error)
+	ModifyNameServers(orderID int64, ns []string) (*DomainModifyNameServersResponse, error)
+	ModifyNameServersContext(ctx context.Context, orderID int64, ns []string) (*DomainModifyNameServersResponse, error)
+	AddChildNameServer(orderID int64, host string, ips []string) (*DomainChildNameServerResponse, error)
+	AddChildNameServerContext(ctx context.Context, orderID int64, host string, ips []string) (*DomainChildNameServerResponse, error)
+	ModifyChildNameServerHost(orderID int64, oldHost string, newHost string) (*DomainChildNameServerResponse, error)
+	ModifyChildNameServerHostContext(ctx context.Context, orderID int64, oldHost string, newHost string) (*DomainChildNameServerResponse, error)
+	ModifyChildNameServerIP(orderID int64, host string, oldIP string, newIP string) (*DomainChildNameServerResponse, error)
+	ModifyChildNameServerIPContext(ctx context.Context, orderID int64, host string, oldIP string, newIP string) (*DomainChildNameServerResponse, error)
+	DeleteChildNameServerIP(orderID int64, host string, ips []string) (*DomainChildNameServerResponse, error)
+	DeleteChildNameServerIPContext(ctx context.Context, orderID int64, host string, ips []string) (*DomainChildNameServerResponse, error)
+	ModifyContacts(orderID int64, regContactID int64, adminContactID int64, techContactID int64, billingContactID int64, options ...ModifyContactsOption) (*DomainModifyContactsResponse, error)
+	ModifyContactsContext(ctx context.Context, orderID int64, regContactID int64, adminContactID int64, techContactID int64, billingContactID int64, options ...ModifyContactsOption) (*DomainModifyContactsResponse, error)
+}
+
+var _ DomainsService = (*Domains)(nil)
+
 type Domains struct {
 	client *Client
 }
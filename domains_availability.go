@@ -1,8 +1,8 @@
 package resellerclub
 
 import (
+	"context"
 	"encoding/json"
-	"io/ioutil"
 	"net/http"
 	"sort"
 )
@@ -23,6 +23,15 @@ type DomainAvailabilityResponse struct {
 // CheckAvailability checks domains' availability.
 // https://manage.resellerclub.com/kb/answer/764
 func (domains *Domains) CheckAvailability(domainNames []string, tlds []string) ([]*DomainAvailabilityResponse, error) {
+	return domains.CheckAvailabilityContext(context.Background(), domainNames, tlds)
+}
+
+// CheckAvailabilityContext is like CheckAvailability but uses the given context for the API call.
+func (domains *Domains) CheckAvailabilityContext(ctx context.Context, domainNames []string, tlds []string) ([]*DomainAvailabilityResponse, error) {
+	if cached, ok := domains.cachedAvailability(domainNames, tlds); ok {
+		return cached, nil
+	}
+
 	u := domains.url("/available.json")
 	q := u.Query()
 
@@ -30,18 +39,11 @@ func (domains *Domains) CheckAvailability(domainNames []string, tlds []string) (
 	q["tlds"] = tlds
 	u.RawQuery = q.Encode()
 
-	resp, err := http.Get(u.String())
+	body, err := domains.client.call(ctx, http.MethodGet, u)
 	if err != nil {
 		return nil, err
 	}
 
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, somethingWentWrong(string(body))
-	}
-
 	mapResp := map[string]interface{}{}
 	err = json.Unmarshal(body, &mapResp)
 	if err != nil {
@@ -82,5 +84,54 @@ func (domains *Domains) CheckAvailability(domainNames []string, tlds []string) (
 		return domainsAvailability[i].Domain < domainsAvailability[j].Domain
 	})
 
+	domains.cacheAvailability(domainsAvailability)
+
 	return domainsAvailability, nil
 }
+
+// cachedAvailability returns the availability of every domain name from the cache, if all of them are there.
+func (domains *Domains) cachedAvailability(domainNames []string, tlds []string) ([]*DomainAvailabilityResponse, bool) {
+	cache := domains.client.cache
+	if cache == nil || domains.client.cacheTTL.Availability <= 0 || len(domainNames) == 0 || len(tlds) == 0 {
+		return nil, false
+	}
+
+	domainsAvailability := []*DomainAvailabilityResponse{}
+	for _, name := range domainNames {
+		for _, tld := range tlds {
+			value, ok := cache.Get(domains.client.cacheKey(availabilityCacheKey(name + "." + tld)))
+			if !ok {
+				return nil, false
+			}
+
+			var d DomainAvailabilityResponse
+			if err := json.Unmarshal(value, &d); err != nil {
+				return nil, false
+			}
+
+			domainsAvailability = append(domainsAvailability, &d)
+		}
+	}
+
+	sort.Slice(domainsAvailability, func(i, j int) bool {
+		return domainsAvailability[i].Domain < domainsAvailability[j].Domain
+	})
+
+	return domainsAvailability, true
+}
+
+func (domains *Domains) cacheAvailability(domainsAvailability []*DomainAvailabilityResponse) {
+	cache := domains.client.cache
+	if cache == nil || domains.client.cacheTTL.Availability <= 0 {
+		return
+	}
+
+	for _, d := range domainsAvailability {
+		value, err := json.Marshal(d)
+		if err != nil {
+			continue
+		}
+
+		cache.Set(domains.client.cacheKey(availabilityCacheKey(d.Domain)), value, domains.client.cacheTTL.Availability)
+	}
+}
@@ -0,0 +1,149 @@
+package resellerclub
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+)
+
+type DomainChildNameServerResponse struct {
+	DomainCommonResponse
+}
+
+type resDomainChildNameServerResponse struct {
+	errorResponse
+	DomainChildNameServerResponse
+}
+
+// AddChildNameServer adds a Child Name Server (glue record), like ns1.example.com, to a domain name
+// with the given IPv4 and/or IPv6 addresses.
+// https://manage.resellerclub.com/kb/answer/780
+func (domains *Domains) AddChildNameServer(orderID int64, host string, ips []string) (*DomainChildNameServerResponse, error) {
+	return domains.AddChildNameServerContext(context.Background(), orderID, host, ips)
+}
+
+// AddChildNameServerContext is like AddChildNameServer but uses the given context for the API call.
+func (domains *Domains) AddChildNameServerContext(ctx context.Context, orderID int64, host string, ips []string) (*DomainChildNameServerResponse, error) {
+	if err := validateChildNameServer(host, ips); err != nil {
+		return nil, err
+	}
+
+	u := domains.url("/add-cns.json")
+	q := u.Query()
+
+	q.Set("order-id", strconv.FormatInt(orderID, 10))
+	q.Set("cns", host)
+	q["ip"] = ips
+
+	u.RawQuery = q.Encode()
+
+	return domains.childNameServerCall(ctx, orderID, u)
+}
+
+// ModifyChildNameServerHost renames a Child Name Server of a domain name.
+// https://manage.resellerclub.com/kb/answer/781
+func (domains *Domains) ModifyChildNameServerHost(orderID int64, oldHost string, newHost string) (*DomainChildNameServerResponse, error) {
+	return domains.ModifyChildNameServerHostContext(context.Background(), orderID, oldHost, newHost)
+}
+
+// ModifyChildNameServerHostContext is like ModifyChildNameServerHost but uses the given context for the API call.
+func (domains *Domains) ModifyChildNameServerHostContext(ctx context.Context, orderID int64, oldHost string, newHost string) (*DomainChildNameServerResponse, error) {
+	for _, host := range []string{oldHost, newHost} {
+		if !isHostname(host) {
+			return nil, fmt.Errorf("%w: %q is not a valid host name", ErrInvalidNameServers, host)
+		}
+	}
+
+	u := domains.url("/modify-cns-name.json")
+	q := u.Query()
+
+	q.Set("order-id", strconv.FormatInt(orderID, 10))
+	q.Set("old-cns", oldHost)
+	q.Set("new-cns", newHost)
+
+	u.RawQuery = q.Encode()
+
+	return domains.childNameServerCall(ctx, orderID, u)
+}
+
+// ModifyChildNameServerIP replaces an IP address of a Child Name Server of a domain name.
+// https://manage.resellerclub.com/kb/answer/782
+func (domains *Domains) ModifyChildNameServerIP(orderID int64, host string, oldIP string, newIP string) (*DomainChildNameServerResponse, error) {
+	return domains.ModifyChildNameServerIPContext(context.Background(), orderID, host, oldIP, newIP)
+}
+
+// ModifyChildNameServerIPContext is like ModifyChildNameServerIP but uses the given context for the API call.
+func (domains *Domains) ModifyChildNameServerIPContext(ctx context.Context, orderID int64, host string, oldIP string, newIP string) (*DomainChildNameServerResponse, error) {
+	if err := validateChildNameServer(host, []string{oldIP, newIP}); err != nil {
+		return nil, err
+	}
+
+	u := domains.url("/modify-cns-ip.json")
+	q := u.Query()
+
+	q.Set("order-id", strconv.FormatInt(orderID, 10))
+	q.Set("cns", host)
+	q.Set("old-ip", oldIP)
+	q.Set("new-ip", newIP)
+
+	u.RawQuery = q.Encode()
+
+	return domains.childNameServerCall(ctx, orderID, u)
+}
+
+// DeleteChildNameServerIP removes IP addresses from a Child Name Server of a domain name.
+// The Child Name Server is deleted along with its last IP address.
+// https://manage.resellerclub.com/kb/answer/934
+func (domains *Domains) DeleteChildNameServerIP(orderID int64, host string, ips []string) (*DomainChildNameServerResponse, error) {
+	return domains.DeleteChildNameServerIPContext(context.Background(), orderID, host, ips)
+}
+
+// DeleteChildNameServerIPContext is like DeleteChildNameServerIP but uses the given context for the API call.
+func (domains *Domains) DeleteChildNameServerIPContext(ctx context.Context, orderID int64, host string, ips []string) (*DomainChildNameServerResponse, error) {
+	if err := validateChildNameServer(host, ips); err != nil {
+		return nil, err
+	}
+
+	u := domains.url("/delete-cns-ip.json")
+	q := u.Query()
+
+	q.Set("order-id", strconv.FormatInt(orderID, 10))
+	q.Set("cns", host)
+	q["ip"] = ips
+
+	u.RawQuery = q.Encode()
+
+	return domains.childNameServerCall(ctx, orderID, u)
+}
+
+func (domains *Domains) childNameServerCall(ctx context.Context, orderID int64, u *url.URL) (*DomainChildNameServerResponse, error) {
+	var res = resDomainChildNameServerResponse{}
+	err := domains.client.post(ctx, u, &res)
+	domains.client.invalidateOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.DomainChildNameServerResponse, nil
+}
+
+// validateChildNameServer checks the host name of a Child Name Server and that at least one valid IPv4 or IPv6 address is given.
+func validateChildNameServer(host string, ips []string) error {
+	if !isHostname(host) {
+		return fmt.Errorf("%w: %q is not a valid host name", ErrInvalidNameServers, host)
+	}
+
+	if len(ips) == 0 {
+		return fmt.Errorf("%w: at least one is required", ErrInvalidIPAddress)
+	}
+
+	for _, ip := range ips {
+		if net.ParseIP(ip) == nil {
+			return fmt.Errorf("%w: %q is not an IPv4 or IPv6 address", ErrInvalidIPAddress, ip)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package resellerclub
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateChildNameServer(t *testing.T) {
+	tests := []struct {
+		name string
+		host string
+		ips  []string
+		want error
+	}{
+		{"IPv4", "ns1.example.com", []string{"192.0.2.1"}, nil},
+		{"IPv6", "ns1.example.com", []string{"2001:db8::1"}, nil},
+		{"IPv4 and IPv6", "ns1.example.com", []string{"192.0.2.1", "2001:db8::1"}, nil},
+		{"IPv4-mapped IPv6", "ns1.example.com", []string{"::ffff:192.0.2.1"}, nil},
+		{"no IP", "ns1.example.com", nil, ErrInvalidIPAddress},
+		{"bad IPv4", "ns1.example.com", []string{"192.0.2.256"}, ErrInvalidIPAddress},
+		{"short IPv4", "ns1.example.com", []string{"192.0.2"}, ErrInvalidIPAddress},
+		{"bad IPv6", "ns1.example.com", []string{"2001:db8::g"}, ErrInvalidIPAddress},
+		{"IPv6 with two ::", "ns1.example.com", []string{"2001::db8::1"}, ErrInvalidIPAddress},
+		{"CIDR", "ns1.example.com", []string{"192.0.2.0/24"}, ErrInvalidIPAddress},
+		{"one bad IP among good ones", "ns1.example.com", []string{"192.0.2.1", "example.com"}, ErrInvalidIPAddress},
+		{"bad host", "ns1", []string{"192.0.2.1"}, ErrInvalidNameServers},
+	}
+
+	for _, tt := range tests {
+		err := validateChildNameServer(tt.host, tt.ips)
+		if tt.want == nil && err != nil {
+			t.Errorf("%v: got %v", tt.name, err)
+		}
+		if tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("%v: got %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
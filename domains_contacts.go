@@ -0,0 +1,84 @@
+package resellerclub
+
+import (
+	"context"
+	"strconv"
+)
+
+type ModifyContactsOption string
+
+const (
+	// Opts out of the 60 day Inter-Registrar transfer lock applied after a change of registrant.
+	ModifyContactsOptionSixtyDayLockOptOut ModifyContactsOption = "sixty-day-lock-optout"
+
+	// Approves the change of registrant as Designated Agent of the current and the new registrant,
+	// so it doesn't wait for their confirmation.
+	ModifyContactsOptionDesignatedAgent ModifyContactsOption = "designated-agent"
+)
+
+// Values of ActionStatus.
+const (
+	// The action was executed right away.
+	ActionStatusSuccess = "Success"
+
+	// The action waits for an approval or for the registry.
+	ActionStatusPending          = "Pending"
+	ActionStatusPendingExecution = "PendingExecution"
+)
+
+type DomainModifyContactsResponse struct {
+	DomainCommonResponse
+}
+
+// Pending tells whether the modification waits for approval, e.g. a change of registrant
+// under the ICANN transfer policy that the current and the new registrant have to confirm.
+// It completes when GetOrderDetails answers the new RegistrantContactID.
+// Only ActionStatusPending and ActionStatusPendingExecution are pending; any other value,
+// including an empty one, is not, so check ActionStatus for the statuses this doesn't know.
+func (res *DomainModifyContactsResponse) Pending() bool {
+	switch res.ActionStatus {
+	case ActionStatusPending, ActionStatusPendingExecution:
+		return true
+	}
+
+	return false
+}
+
+type resDomainModifyContactsResponse struct {
+	errorResponse
+	DomainModifyContactsResponse
+}
+
+// ModifyContacts modifies the Registrant, Administrative, Technical and Billing Contacts of a domain name.
+// Pass -1 for the contacts not supported by the TLD, as in Register.
+// https://manage.resellerclub.com/kb/answer/777
+func (domains *Domains) ModifyContacts(orderID int64, regContactID int64, adminContactID int64, techContactID int64, billingContactID int64, options ...ModifyContactsOption) (*DomainModifyContactsResponse, error) {
+	return domains.ModifyContactsContext(context.Background(), orderID, regContactID, adminContactID, techContactID, billingContactID, options...)
+}
+
+// ModifyContactsContext is like ModifyContacts but uses the given context for the API call.
+func (domains *Domains) ModifyContactsContext(ctx context.Context, orderID int64, regContactID int64, adminContactID int64, techContactID int64, billingContactID int64, options ...ModifyContactsOption) (*DomainModifyContactsResponse, error) {
+	u := domains.url("/modify-contact.json")
+	q := u.Query()
+
+	q.Set("order-id", strconv.FormatInt(orderID, 10))
+	q.Set("reg-contact-id", strconv.FormatInt(regContactID, 10))
+	q.Set("admin-contact-id", strconv.FormatInt(adminContactID, 10))
+	q.Set("tech-contact-id", strconv.FormatInt(techContactID, 10))
+	q.Set("billing-contact-id", strconv.FormatInt(billingContactID, 10))
+
+	for _, opt := range options {
+		q.Set(string(opt), strconv.FormatBool(true))
+	}
+
+	u.RawQuery = q.Encode()
+
+	var res = resDomainModifyContactsResponse{}
+	err := domains.client.post(ctx, u, &res)
+	domains.client.invalidateOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.DomainModifyContactsResponse, nil
+}
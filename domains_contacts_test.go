@@ -0,0 +1,82 @@
+package resellerclub_test
+
+import (
+	"testing"
+
+	"github.com/saulortega/resellerclub"
+	"github.com/saulortega/resellerclub/resellerclubtest"
+)
+
+func TestModifyContactsPending(t *testing.T) {
+	for status, want := range map[string]bool{
+		resellerclub.ActionStatusSuccess:          false,
+		resellerclub.ActionStatusPending:          true,
+		resellerclub.ActionStatusPendingExecution: true,
+		"":       false,
+		"Failed": false,
+	} {
+		res := resellerclub.DomainModifyContactsResponse{}
+		res.ActionStatus = status
+		if got := res.Pending(); got != want {
+			t.Errorf("Pending() with ActionStatus %q: got %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestModifyContactsRegistrantChange(t *testing.T) {
+	s := resellerclubtest.NewServer()
+	defer s.Close()
+	client := s.Client()
+
+	customerID := s.AddCustomer(resellerclubtest.Customer{Username: "jane@example.com"})
+	oldContactID := s.AddContact(resellerclubtest.Contact{CustomerID: customerID})
+	newContactID := s.AddContact(resellerclubtest.Contact{CustomerID: customerID})
+	orderID := s.AddOrder(resellerclubtest.Order{
+		CustomerID:       customerID,
+		DomainName:       "example.com",
+		RegContactID:     oldContactID,
+		AdminContactID:   oldContactID,
+		TechContactID:    oldContactID,
+		BillingContactID: oldContactID,
+	})
+
+	registrant := func() int64 {
+		t.Helper()
+
+		res, err := client.Domains.GetOrderDetails(orderID, resellerclub.OrderDetailsOptionContactIds)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return int64(res.RegistrantContactID)
+	}
+
+	res, err := client.Domains.ModifyContacts(orderID, newContactID, oldContactID, oldContactID, oldContactID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Pending() {
+		t.Fatalf("got ActionStatus %q, want a pending change", res.ActionStatus)
+	}
+	if got := registrant(); got != oldContactID {
+		t.Errorf("before the approval: got registrant %v, want %v", got, oldContactID)
+	}
+
+	if !s.ApproveRegistrantChange(orderID) {
+		t.Fatal("no change of registrant to approve")
+	}
+	if got := registrant(); got != newContactID {
+		t.Errorf("after the approval: got registrant %v, want %v", got, newContactID)
+	}
+
+	res, err = client.Domains.ModifyContacts(orderID, oldContactID, oldContactID, oldContactID, oldContactID, resellerclub.ModifyContactsOptionDesignatedAgent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Pending() {
+		t.Errorf("as Designated Agent: got ActionStatus %q, want no pending change", res.ActionStatus)
+	}
+	if got := registrant(); got != oldContactID {
+		t.Errorf("as Designated Agent: got registrant %v, want %v", got, oldContactID)
+	}
+}
@@ -0,0 +1,102 @@
+package resellerclub
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	minNameServers = 2
+	maxNameServers = 13
+)
+
+type DomainModifyNameServersResponse struct {
+	DomainCommonResponse
+}
+
+type resDomainModifyNameServersResponse struct {
+	errorResponse
+	DomainModifyNameServersResponse
+}
+
+// ModifyNameServers modifies the Name Servers of a domain name.
+// Between 2 and 13 valid host names are required; they are checked before calling the API.
+// https://manage.resellerclub.com/kb/answer/776
+func (domains *Domains) ModifyNameServers(orderID int64, ns []string) (*DomainModifyNameServersResponse, error) {
+	return domains.ModifyNameServersContext(context.Background(), orderID, ns)
+}
+
+// ModifyNameServersContext is like ModifyNameServers but uses the given context for the API call.
+func (domains *Domains) ModifyNameServersContext(ctx context.Context, orderID int64, ns []string) (*DomainModifyNameServersResponse, error) {
+	if err := validateNameServers(ns); err != nil {
+		return nil, err
+	}
+
+	u := domains.url("/modify-ns.json")
+	q := u.Query()
+
+	q.Set("order-id", strconv.FormatInt(orderID, 10))
+	q["ns"] = ns
+
+	u.RawQuery = q.Encode()
+
+	var res = resDomainModifyNameServersResponse{}
+	err := domains.client.post(ctx, u, &res)
+	domains.client.invalidateOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.DomainModifyNameServersResponse, nil
+}
+
+// validateNameServers checks the count of name servers and that they are distinct valid host names.
+func validateNameServers(ns []string) error {
+	if len(ns) < minNameServers || len(ns) > maxNameServers {
+		return fmt.Errorf("%w: between %v and %v are required, got %v", ErrInvalidNameServers, minNameServers, maxNameServers, len(ns))
+	}
+
+	seen := map[string]bool{}
+	for _, host := range ns {
+		if !isHostname(host) {
+			return fmt.Errorf("%w: %q is not a valid host name", ErrInvalidNameServers, host)
+		}
+
+		key := strings.ToLower(strings.TrimSuffix(host, "."))
+		if seen[key] {
+			return fmt.Errorf("%w: %q is repeated", ErrInvalidNameServers, host)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// isHostname tells whether host is a fully qualified host name, like ns1.example.com.
+func isHostname(host string) bool {
+	host = strings.TrimSuffix(host, ".")
+	if len(host) == 0 || len(host) > 253 {
+		return false
+	}
+
+	labels := strings.Split(host, ".")
+	if len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
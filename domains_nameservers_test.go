@@ -0,0 +1,71 @@
+package resellerclub
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func nameServers(n int) []string {
+	ns := make([]string, n)
+	for i := range ns {
+		ns[i] = fmt.Sprintf("ns%v.example.com", i+1)
+	}
+
+	return ns
+}
+
+func TestValidateNameServers(t *testing.T) {
+	tests := []struct {
+		name  string
+		ns    []string
+		valid bool
+	}{
+		{"none", nil, false},
+		{"one", nameServers(1), false},
+		{"two", nameServers(2), true},
+		{"thirteen", nameServers(13), true},
+		{"fourteen", nameServers(14), false},
+		{"duplicate", []string{"ns1.example.com", "ns2.example.com", "ns1.example.com"}, false},
+		{"duplicate with another case and a trailing dot", []string{"ns1.example.com", "NS1.Example.com."}, false},
+		{"bad label", []string{"ns1.example.com", "ns_2.example.com"}, false},
+	}
+
+	for _, tt := range tests {
+		err := validateNameServers(tt.ns)
+		if tt.valid && err != nil {
+			t.Errorf("%v: got %v", tt.name, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidNameServers) {
+			t.Errorf("%v: got %v, want ErrInvalidNameServers", tt.name, err)
+		}
+	}
+}
+
+func TestIsHostname(t *testing.T) {
+	tests := map[string]bool{
+		"ns1.example.com":     true,
+		"ns1.example.com.":    true,
+		"NS-1.Example.co.uk":  true,
+		"1.2.3.4.example.com": true,
+		"localhost":           false,
+		"":                    false,
+		".":                   false,
+		"ns1..example.com":    false,
+		".ns1.example.com":    false,
+		"-ns1.example.com":    false,
+		"ns1-.example.com":    false,
+		"ns_1.example.com":    false,
+		"ns1.exa mple.com":    false,
+		"ns1.exämple.com":     false,
+		"ns1." + strings.Repeat("a", 64) + ".com": false,
+		"ns1." + strings.Repeat("a", 63) + ".com": true,
+	}
+
+	for host, want := range tests {
+		if got := isHostname(host); got != want {
+			t.Errorf("isHostname(%q): got %v, want %v", host, got, want)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package resellerclub
 
+import "context"
+
 type OrderContact struct {
 	Company       string   `json:"company"`
 	Address1      string   `json:"address1"`
@@ -22,6 +24,11 @@ type OrderContact struct {
 // GetOrderID gets the Order Id of a Registered domain name.
 // https://manage.resellerclub.com/kb/answer/763
 func (domains *Domains) GetOrderID(domainName string) (int64, error) {
+	return domains.GetOrderIDContext(context.Background(), domainName)
+}
+
+// GetOrderIDContext is like GetOrderID but uses the given context for the API call.
+func (domains *Domains) GetOrderIDContext(ctx context.Context, domainName string) (int64, error) {
 	u := domains.url("/orderid.json")
 	q := u.Query()
 
@@ -29,7 +36,7 @@ func (domains *Domains) GetOrderID(domainName string) (int64, error) {
 
 	u.RawQuery = q.Encode()
 
-	id, err := domains.client.getInt64(u.String())
+	id, err := domains.client.getInt64Cached(ctx, u, orderIDCacheKey(domainName), domains.client.cacheTTL.OrderID)
 	if err != nil {
 		return 0, err
 	}
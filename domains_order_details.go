@@ -1,6 +1,11 @@
 package resellerclub
 
-import "strconv"
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+)
 
 type OrderDetailsOption string
 
@@ -74,6 +79,12 @@ type DomainGetOrderDetailsResponse struct {
 	// Name Server 2
 	NS2 string `json:"ns2"`
 
+	// All the Name Servers of the domain name (ns1, ns2, ... ns13), in order
+	NameServers []string `json:"-"`
+
+	// Child Name Servers (glue records) of the domain name, sorted by host name
+	ChildNameServers []ChildNameServer `json:"-"`
+
 	// Domain Secret
 	DomainSecret string `json:"domsecret"`
 
@@ -153,6 +164,12 @@ type DomainGetOrderDetailsResponse struct {
 	MultilingualFlag         string  `json:"multilingualflag"`
 }
 
+// ChildNameServer is a Child Name Server (glue record) of a domain name, like ns1.example.com.
+type ChildNameServer struct {
+	Host string
+	IPs  []string
+}
+
 type DomainGetOrderDetailsResponseGDPR struct {
 	Enabled  Bool `json:"enabled"`
 	Eligible Bool `json:"eligible"`
@@ -163,9 +180,49 @@ type resDomainGetOrderDetailsResponse struct {
 	DomainGetOrderDetailsResponse
 }
 
+func (res *resDomainGetOrderDetailsResponse) UnmarshalJSON(data []byte) error {
+	type plain resDomainGetOrderDetailsResponse
+	if err := json.Unmarshal(data, (*plain)(res)); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	// The API answers every Name Server in its own numbered field.
+	res.NameServers = nil
+	for i := 1; i <= maxNameServers; i++ {
+		var ns string
+		if raw, ok := fields["ns"+strconv.Itoa(i)]; ok && json.Unmarshal(raw, &ns) == nil && len(ns) > 0 {
+			res.NameServers = append(res.NameServers, ns)
+		}
+	}
+
+	// Child Name Servers are answered as a map of host name to IP addresses.
+	res.ChildNameServers = nil
+	var cns map[string][]string
+	if raw, ok := fields["cns"]; ok && json.Unmarshal(raw, &cns) == nil {
+		for host, ips := range cns {
+			res.ChildNameServers = append(res.ChildNameServers, ChildNameServer{Host: host, IPs: ips})
+		}
+		sort.Slice(res.ChildNameServers, func(i, j int) bool {
+			return res.ChildNameServers[i].Host < res.ChildNameServers[j].Host
+		})
+	}
+
+	return nil
+}
+
 // GetOrderDetails Gets details of the Domain Registration Order associated with the specified Order Id.
 // https://manage.resellerclub.com/kb/answer/770
 func (domains *Domains) GetOrderDetails(orderID int64, options ...OrderDetailsOption) (*DomainGetOrderDetailsResponse, error) {
+	return domains.GetOrderDetailsContext(context.Background(), orderID, options...)
+}
+
+// GetOrderDetailsContext is like GetOrderDetails but uses the given context for the API call.
+func (domains *Domains) GetOrderDetailsContext(ctx context.Context, orderID int64, options ...OrderDetailsOption) (*DomainGetOrderDetailsResponse, error) {
 	u := domains.url("/details.json")
 	q := u.Query()
 
@@ -178,7 +235,7 @@ func (domains *Domains) GetOrderDetails(orderID int64, options ...OrderDetailsOp
 	u.RawQuery = q.Encode()
 
 	var res = resDomainGetOrderDetailsResponse{}
-	err := domains.client.get(u.String(), &res)
+	err := domains.client.getCached(ctx, u, orderDetailsCacheKey(orderID, options), domains.client.cacheTTL.OrderDetails, &res)
 	if err != nil {
 		return nil, err
 	}
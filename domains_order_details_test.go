@@ -0,0 +1,83 @@
+package resellerclub_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/saulortega/resellerclub"
+	"github.com/saulortega/resellerclub/resellerclubtest"
+)
+
+func TestGetOrderDetailsNameServers(t *testing.T) {
+	s := resellerclubtest.NewServer()
+	defer s.Close()
+	client := s.Client()
+
+	ns := []string{"ns1.example.net", "ns2.example.net", "ns3.example.net", "ns4.example.net", "ns5.example.net"}
+	orderID := s.AddOrder(resellerclubtest.Order{DomainName: "example.com", NS: ns})
+
+	res, err := client.Domains.GetOrderDetails(orderID, resellerclub.OrderDetailsOptionNsDetails)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(res.NameServers, ns) {
+		t.Errorf("got name servers %v, want %v", res.NameServers, ns)
+	}
+	if res.NS1 != ns[0] || res.NS2 != ns[1] || res.NoOfNameServers != 5 {
+		t.Errorf("got ns1 %v, ns2 %v and %v name servers", res.NS1, res.NS2, res.NoOfNameServers)
+	}
+
+	ns = []string{"ns1.example.org", "ns2.example.org", "ns3.example.org", "ns4.example.org"}
+	if _, err = client.Domains.ModifyNameServers(orderID, ns); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err = client.Domains.GetOrderDetails(orderID, resellerclub.OrderDetailsOptionNsDetails)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(res.NameServers, ns) {
+		t.Errorf("after ModifyNameServers: got name servers %v, want %v", res.NameServers, ns)
+	}
+}
+
+func TestGetOrderDetailsChildNameServers(t *testing.T) {
+	s := resellerclubtest.NewServer()
+	defer s.Close()
+	client := s.Client()
+
+	orderID := s.AddOrder(resellerclubtest.Order{
+		DomainName: "example.com",
+		NS:         []string{"ns1.example.com", "ns2.example.com"},
+		CNS:        map[string][]string{"ns2.example.com": {"2001:db8::2"}},
+	})
+
+	if _, err := client.Domains.AddChildNameServer(orderID, "ns1.example.com", []string{"192.0.2.1", "2001:db8::1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.Domains.GetOrderDetails(orderID, resellerclub.OrderDetailsOptionNsDetails)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []resellerclub.ChildNameServer{
+		{Host: "ns1.example.com", IPs: []string{"192.0.2.1", "2001:db8::1"}},
+		{Host: "ns2.example.com", IPs: []string{"2001:db8::2"}},
+	}
+	if !reflect.DeepEqual(res.ChildNameServers, want) {
+		t.Errorf("got child name servers %+v, want %+v", res.ChildNameServers, want)
+	}
+
+	if _, err = client.Domains.DeleteChildNameServerIP(orderID, "ns2.example.com", []string{"2001:db8::2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err = client.Domains.GetOrderDetails(orderID, resellerclub.OrderDetailsOptionNsDetails)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(res.ChildNameServers, want[:1]) {
+		t.Errorf("after DeleteChildNameServerIP: got child name servers %+v, want %+v", res.ChildNameServers, want[:1])
+	}
+}
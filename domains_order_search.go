@@ -1,8 +1,8 @@
 package resellerclub
 
 import (
+	"context"
 	"encoding/json"
-	"io/ioutil"
 	"net/http"
 	"sort"
 	"strconv"
@@ -94,6 +94,11 @@ type DomainSearchResponseItem struct {
 // Search gets a list of Domain Registration Orders matching the search criteria, along with the details.
 // https://manage.resellerclub.com/kb/answer/771
 func (domains *Domains) Search(params *DomainSearchParams) ([]*DomainSearchResponseItem, error) {
+	return domains.SearchContext(context.Background(), params)
+}
+
+// SearchContext is like Search but uses the given context for the API call.
+func (domains *Domains) SearchContext(ctx context.Context, params *DomainSearchParams) ([]*DomainSearchResponseItem, error) {
 	if params == nil {
 		return nil, ErrMissingParams
 	}
@@ -151,18 +156,11 @@ func (domains *Domains) Search(params *DomainSearchParams) ([]*DomainSearchRespo
 
 	u.RawQuery = q.Encode()
 
-	resp, err := http.Get(u.String())
+	body, err := domains.client.call(ctx, http.MethodGet, u)
 	if err != nil {
 		return nil, err
 	}
 
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, somethingWentWrong(string(body))
-	}
-
 	var errRes errorResponse
 	err = json.Unmarshal(body, &errRes)
 	if err != nil {
@@ -1,6 +1,7 @@
 package resellerclub
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 )
@@ -81,6 +82,11 @@ type resDomainRegisterResponse struct {
 // Register registers a domain name.
 // https://manage.resellerclub.com/kb/answer/752
 func (domains *Domains) Register(params *DomainRegisterParams) (*DomainRegisterResponse, error) {
+	return domains.RegisterContext(context.Background(), params)
+}
+
+// RegisterContext is like Register but uses the given context for the API call.
+func (domains *Domains) RegisterContext(ctx context.Context, params *DomainRegisterParams) (*DomainRegisterResponse, error) {
 	if params == nil {
 		return nil, ErrMissingParams
 	}
@@ -122,7 +128,9 @@ func (domains *Domains) Register(params *DomainRegisterParams) (*DomainRegisterR
 	u.RawQuery = q.Encode()
 
 	var res = resDomainRegisterResponse{}
-	err := domains.client.post(u.String(), &res)
+	err := domains.client.post(ctx, u, &res)
+	domains.client.invalidateDomain(params.DomainName)
+	domains.client.invalidateOrder(int64(res.EntityID))
 	if err != nil {
 		return nil, err
 	}
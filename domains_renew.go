@@ -1,6 +1,7 @@
 package resellerclub
 
 import (
+	"context"
 	"strconv"
 	"time"
 )
@@ -54,6 +55,11 @@ type resDomainRenewResponse struct {
 // Renew renews a domain name.
 // https://manage.resellerclub.com/kb/answer/746
 func (domains *Domains) Renew(params *DomainRenewParams) (*DomainRenewResponse, error) {
+	return domains.RenewContext(context.Background(), params)
+}
+
+// RenewContext is like Renew but uses the given context for the API call.
+func (domains *Domains) RenewContext(ctx context.Context, params *DomainRenewParams) (*DomainRenewResponse, error) {
 	if params == nil {
 		return nil, ErrMissingParams
 	}
@@ -80,7 +86,8 @@ func (domains *Domains) Renew(params *DomainRenewParams) (*DomainRenewResponse,
 	u.RawQuery = q.Encode()
 
 	var res = resDomainRenewResponse{}
-	err := domains.client.post(u.String(), &res)
+	err := domains.client.post(ctx, u, &res)
+	domains.client.invalidateOrder(params.OrderID)
 	if err != nil {
 		return nil, err
 	}
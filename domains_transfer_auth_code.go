@@ -0,0 +1,46 @@
+package resellerclub
+
+import (
+	"context"
+	"strconv"
+)
+
+type DomainSubmitAuthCodeResponse struct {
+	DomainCommonResponse
+}
+
+type resDomainSubmitAuthCodeResponse struct {
+	errorResponse
+	DomainSubmitAuthCodeResponse
+}
+
+// SubmitAuthCode submits the Domain Secret / Authorization Code of a Transfer order
+// placed without it, or with a wrong one.
+// https://manage.resellerclub.com/kb/answer/2447
+func (domains *Domains) SubmitAuthCode(orderID int64, authCode string) (*DomainSubmitAuthCodeResponse, error) {
+	return domains.SubmitAuthCodeContext(context.Background(), orderID, authCode)
+}
+
+// SubmitAuthCodeContext is like SubmitAuthCode but uses the given context for the API call.
+func (domains *Domains) SubmitAuthCodeContext(ctx context.Context, orderID int64, authCode string) (*DomainSubmitAuthCodeResponse, error) {
+	if len(authCode) == 0 {
+		return nil, ErrMissingParams
+	}
+
+	u := domains.url("/transfer/submit-auth-code.json")
+	q := u.Query()
+
+	q.Set("order-id", strconv.FormatInt(orderID, 10))
+	q.Set("auth-code", authCode)
+
+	u.RawQuery = q.Encode()
+
+	var res = resDomainSubmitAuthCodeResponse{}
+	err := domains.client.post(ctx, u, &res)
+	domains.client.invalidateOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.DomainSubmitAuthCodeResponse, nil
+}
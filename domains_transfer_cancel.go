@@ -0,0 +1,41 @@
+package resellerclub
+
+import (
+	"context"
+	"strconv"
+)
+
+type DomainCancelTransferResponse struct {
+	// Result of the cancellation, e.g. Success.
+	Result string `json:"result"`
+}
+
+type resDomainCancelTransferResponse struct {
+	errorResponse
+	DomainCancelTransferResponse
+}
+
+// CancelTransfer cancels a Transfer order that has not been completed yet.
+// https://manage.resellerclub.com/kb/answer/1151
+func (domains *Domains) CancelTransfer(orderID int64) (*DomainCancelTransferResponse, error) {
+	return domains.CancelTransferContext(context.Background(), orderID)
+}
+
+// CancelTransferContext is like CancelTransfer but uses the given context for the API call.
+func (domains *Domains) CancelTransferContext(ctx context.Context, orderID int64) (*DomainCancelTransferResponse, error) {
+	u := domains.url("/cancel-transfer.json")
+	q := u.Query()
+
+	q.Set("order-id", strconv.FormatInt(orderID, 10))
+
+	u.RawQuery = q.Encode()
+
+	var res = resDomainCancelTransferResponse{}
+	err := domains.client.post(ctx, u, &res)
+	domains.client.invalidateOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.DomainCancelTransferResponse, nil
+}
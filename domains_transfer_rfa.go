@@ -0,0 +1,30 @@
+package resellerclub
+
+import (
+	"context"
+	"strconv"
+)
+
+// ResendTransferApprovalMail resends the Transfer approval mail (RFA) of a pending Transfer order
+// to the Registrant or Administrative Contact of the domain name.
+// https://manage.resellerclub.com/kb/answer/1152
+func (domains *Domains) ResendTransferApprovalMail(orderID int64) (bool, error) {
+	return domains.ResendTransferApprovalMailContext(context.Background(), orderID)
+}
+
+// ResendTransferApprovalMailContext is like ResendTransferApprovalMail but uses the given context for the API call.
+func (domains *Domains) ResendTransferApprovalMailContext(ctx context.Context, orderID int64) (bool, error) {
+	u := domains.url("/resend-rfa.json")
+	q := u.Query()
+
+	q.Set("order-id", strconv.FormatInt(orderID, 10))
+
+	u.RawQuery = q.Encode()
+
+	sent, err := domains.client.postBool(ctx, u)
+	if err != nil {
+		return false, err
+	}
+
+	return sent, nil
+}
@@ -0,0 +1,79 @@
+package resellerclub_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/saulortega/resellerclub"
+	"github.com/saulortega/resellerclub/resellerclubtest"
+)
+
+func TestTransferLifecycle(t *testing.T) {
+	s := resellerclubtest.NewServer()
+	defer s.Close()
+	customerID := s.AddCustomer(resellerclubtest.Customer{Username: "jane@example.com"})
+	contactID := s.AddContact(resellerclubtest.Contact{CustomerID: customerID})
+	s.SetAvailability("example.com", resellerclub.DomainStatusRegisteredThroughOthers)
+
+	var paths []string
+	client := s.Client(resellerclub.WithInterceptors(func(next resellerclub.Doer) resellerclub.Doer {
+		return resellerclub.DoerFunc(func(ctx context.Context, req *resellerclub.Request) (*resellerclub.Response, error) {
+			paths = append(paths, req.Path)
+			return next.Do(ctx, req)
+		})
+	}))
+
+	ok, err := client.Domains.ValidateTransfer("example.com")
+	if err != nil || !ok {
+		t.Fatalf("ValidateTransfer: got %v, %v", ok, err)
+	}
+
+	res, err := client.Domains.Transfer(&resellerclub.DomainTransferParams{
+		DomainName:       "example.com",
+		CustomerID:       customerID,
+		RegContactID:     contactID,
+		AdminContactID:   contactID,
+		TechContactID:    contactID,
+		BillingContactID: contactID,
+		InvoiceOption:    "NoInvoice",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	orderID := int64(res.EntityID)
+
+	if _, err = client.Domains.SubmitAuthCode(orderID, "Tr@nsfer-Secret"); err != nil {
+		t.Fatal(err)
+	}
+	if o, _ := s.Order(orderID); o.DomainSecret != "Tr@nsfer-Secret" {
+		t.Errorf("got domain secret %q", o.DomainSecret)
+	}
+
+	if sent, err := client.Domains.ResendTransferApprovalMail(orderID); err != nil || !sent {
+		t.Errorf("ResendTransferApprovalMail: got %v, %v", sent, err)
+	}
+
+	if _, err = client.Domains.CancelTransfer(orderID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Domains.CancelTransfer(orderID); err == nil {
+		t.Error("cancelled a transfer twice")
+	}
+
+	want := []string{
+		"/domains/validate-transfer.json",
+		"/domains/transfer.json",
+		"/domains/transfer/submit-auth-code.json",
+		"/domains/resend-rfa.json",
+		"/domains/cancel-transfer.json",
+		"/domains/cancel-transfer.json",
+	}
+	if len(paths) != len(want) {
+		t.Fatalf("got paths %v, want %v", paths, want)
+	}
+	for i := range want {
+		if paths[i] != want[i] {
+			t.Errorf("call %v: got path %v, want %v", i+1, paths[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package resellerclub
+
+import "context"
+
+// ValidateTransfer checks whether a domain name can be transferred to your account,
+// e.g. it is registered with another registrar and is not locked or recently transferred.
+// https://manage.resellerclub.com/kb/answer/1150
+func (domains *Domains) ValidateTransfer(domainName string) (bool, error) {
+	return domains.ValidateTransferContext(context.Background(), domainName)
+}
+
+// ValidateTransferContext is like ValidateTransfer but uses the given context for the API call.
+func (domains *Domains) ValidateTransferContext(ctx context.Context, domainName string) (bool, error) {
+	u := domains.url("/validate-transfer.json")
+	q := u.Query()
+
+	q.Set("domain-name", domainName)
+
+	u.RawQuery = q.Encode()
+
+	ok, err := domains.client.getBool(ctx, u)
+	if err != nil {
+		return false, err
+	}
+
+	return ok, nil
+}
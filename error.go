@@ -1,7 +1,10 @@
 package resellerclub
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
 	"strings"
 )
 
@@ -9,11 +12,23 @@ var (
 	ErrMissingParams      = errors.New("missing required params")
 	ErrSomethingWentWrong = errors.New("something went wrong")
 	ErrNoTLDsSelected     = errors.New("No TLDs are selected")
+	ErrInvalidNameServers = errors.New("invalid name servers")
+	ErrInvalidIPAddress   = errors.New("invalid IP address")
+	ErrInvalidEndpoint    = errors.New("invalid endpoint")
 )
 
 type Error struct {
-	err string
-	res interface{}
+	err  string
+	res  interface{}
+	code ErrorCode
+}
+
+func newError(msg string, res interface{}) Error {
+	return Error{
+		err:  msg,
+		res:  res,
+		code: classify(msg),
+	}
 }
 
 func (e Error) Error() string {
@@ -28,10 +43,93 @@ func (e Error) Response() interface{} {
 	return e.res
 }
 
+// Code returns the classification of the error.
+func (e Error) Code() ErrorCode {
+	return e.code
+}
+
+// Is reports whether target is a sentinel with the same ErrorCode, like ErrInvalidOrderID,
+// or an error with the same message.
 func (e Error) Is(target error) bool {
+	if t, ok := target.(Error); ok && t.code != ErrorCodeUnknown {
+		return e.code == t.code
+	}
+
 	return e.err == target.Error()
 }
 
+// HTTPError is returned when the API answers with a status code other than 2xx,
+// or with a body that is not JSON, like the HTML page of a proxy.
+type HTTPError struct {
+	StatusCode  int    // HTTP status code
+	ContentType string // Content-Type of the response
+	Body        string // Body of the response, truncated to maxHTTPErrorBody bytes
+	Path        string // API path of the call, e.g. /domains/details.json
+
+	// Error reported by the API in the body, if any.
+	Err error
+}
+
+// maxHTTPErrorBody is the maximum length of HTTPError.Body.
+const maxHTTPErrorBody = 512
+
+func newHTTPError(path string, resp *http.Response, body []byte, err error) *HTTPError {
+	if len(body) > maxHTTPErrorBody {
+		body = body[:maxHTTPErrorBody]
+	}
+
+	return &HTTPError{
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        string(body),
+		Path:        path,
+		Err:         err,
+	}
+}
+
+// Error returns the message of the error reported by the API, if any.
+// Otherwise it describes the status code and the body of the response.
+func (e *HTTPError) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+
+	msg := fmt.Sprintf("HTTP %v %v from %v", e.StatusCode, http.StatusText(e.StatusCode), e.Path)
+	if len(e.ContentType) > 0 {
+		msg += " (" + e.ContentType + ")"
+	}
+	if len(e.Body) > 0 {
+		msg += ": " + e.Body
+	}
+
+	return msg
+}
+
+// Code returns the classification of the error reported by the API, if any.
+// Otherwise 401 and 403 status codes are classified as ErrorCodeAccessDenied.
+func (e *HTTPError) Code() ErrorCode {
+	if code := ErrorCodeOf(e.Err); code != ErrorCodeUnknown {
+		return code
+	}
+
+	if e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden {
+		return ErrorCodeAccessDenied
+	}
+
+	return classify(e.Body)
+}
+
+// Is reports whether target is a sentinel with the same ErrorCode, like ErrAccessDenied.
+func (e *HTTPError) Is(target error) bool {
+	t, ok := target.(Error)
+	return ok && t.code != ErrorCodeUnknown && e.Code() == t.code
+}
+
+// Unwrap returns the error reported by the API, if any.
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
 type errorChecker interface {
 	Err() error
 }
@@ -50,26 +148,40 @@ func (e *errorResponse) Err() error {
 	var status = strings.ToLower(e.Status)
 	if len(status) > 0 {
 		if len(e.Message) > 0 && status == "error" {
-			return Error{e.Message, e}
+			return newError(e.Message, e)
 		}
 
 		return somethingWentWrong(e)
 	}
 
 	if len(e.ErrorValue.Error) > 0 {
-		return Error{e.ErrorValue.Error, e}
+		return newError(e.ErrorValue.Error, e)
 	}
 
 	return nil
 }
 
+// decodeError returns the error reported by the API in a JSON object body, if any.
+func decodeError(body []byte) error {
+	if len(body) == 0 || body[0] != '{' {
+		return nil
+	}
+
+	var e errorResponse
+	if err := json.Unmarshal(body, &e); err != nil {
+		return nil
+	}
+
+	return e.Err()
+}
+
 func checkResponseError(mapResp map[string]interface{}) error {
 	status, ok := mapResp["status"].(string)
 	if ok {
 		status = strings.ToLower(status)
 		msg, ok := mapResp["message"].(string)
 		if ok && len(msg) > 0 && status == "error" {
-			return Error{msg, mapResp}
+			return newError(msg, mapResp)
 		}
 
 		return somethingWentWrong(mapResp)
@@ -79,7 +191,7 @@ func checkResponseError(mapResp map[string]interface{}) error {
 	if ok {
 		msg, ok := errorvalue["error"].(string)
 		if ok && len(msg) > 0 {
-			return Error{msg, mapResp}
+			return newError(msg, mapResp)
 		}
 
 		return somethingWentWrong(mapResp)
@@ -89,8 +201,5 @@ func checkResponseError(mapResp map[string]interface{}) error {
 }
 
 func somethingWentWrong(res interface{}) error {
-	return Error{
-		ErrSomethingWentWrong.Error(),
-		res,
-	}
+	return newError(ErrSomethingWentWrong.Error(), res)
 }
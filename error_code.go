@@ -0,0 +1,93 @@
+package resellerclub
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrorCode classifies the errors returned by the API.
+type ErrorCode int
+
+const (
+	ErrorCodeUnknown ErrorCode = iota
+	ErrorCodeInvalidAPIKey
+	ErrorCodeAccessDenied
+	ErrorCodeDomainNotAvailable
+	ErrorCodeInsufficientFunds
+	ErrorCodeInvalidOrderID
+	ErrorCodeCustomerAlreadyExists
+)
+
+var errorCodeNames = map[ErrorCode]string{
+	ErrorCodeUnknown:               "unknown",
+	ErrorCodeInvalidAPIKey:         "invalid_api_key",
+	ErrorCodeAccessDenied:          "access_denied",
+	ErrorCodeDomainNotAvailable:    "domain_not_available",
+	ErrorCodeInsufficientFunds:     "insufficient_funds",
+	ErrorCodeInvalidOrderID:        "invalid_order_id",
+	ErrorCodeCustomerAlreadyExists: "customer_already_exists",
+}
+
+func (c ErrorCode) String() string {
+	if name, ok := errorCodeNames[c]; ok {
+		return name
+	}
+
+	return errorCodeNames[ErrorCodeUnknown]
+}
+
+// Sentinels of the classified errors, to be used with errors.Is:
+//
+//	if errors.Is(err, resellerclub.ErrInvalidOrderID) {
+//		...
+//	}
+var (
+	ErrInvalidAPIKey         = Error{err: "invalid API key", code: ErrorCodeInvalidAPIKey}
+	ErrAccessDenied          = Error{err: "access denied", code: ErrorCodeAccessDenied}
+	ErrDomainNotAvailable    = Error{err: "domain not available", code: ErrorCodeDomainNotAvailable}
+	ErrInsufficientFunds     = Error{err: "insufficient funds", code: ErrorCodeInsufficientFunds}
+	ErrInvalidOrderID        = Error{err: "invalid order id", code: ErrorCodeInvalidOrderID}
+	ErrCustomerAlreadyExists = Error{err: "customer already exists", code: ErrorCodeCustomerAlreadyExists}
+)
+
+// errorCatalog maps known patterns of the API error messages to their ErrorCode.
+// Patterns are lowercase and matched as substrings, in order.
+var errorCatalog = []struct {
+	code     ErrorCode
+	patterns []string
+}{
+	{ErrorCodeInvalidAPIKey, []string{"invalid api key", "invalid api-key", "invalid auth-userid", "authentication failed", "incorrect api key"}},
+	{ErrorCodeAccessDenied, []string{"access denied", "not whitelisted", "not authorized", "not allowed to access"}},
+	{ErrorCodeInsufficientFunds, []string{"insufficient funds", "insufficient balance", "not enough funds"}},
+	{ErrorCodeInvalidOrderID, []string{"invalid order id", "invalid order-id", "invalid orderid", "order id is invalid", "no entity found", "order not found"}},
+	{ErrorCodeCustomerAlreadyExists, []string{"customer already exists", "username already exists", "email address already exists", "already exists as a customer"}},
+	{ErrorCodeDomainNotAvailable, []string{"domain not available", "domain name not available", "domain is unavailable", "not available for registration", "domain is already registered", "domain name is already registered"}},
+}
+
+func classify(msg string) ErrorCode {
+	msg = strings.ToLower(msg)
+	for _, entry := range errorCatalog {
+		for _, pattern := range entry.patterns {
+			if strings.Contains(msg, pattern) {
+				return entry.code
+			}
+		}
+	}
+
+	return ErrorCodeUnknown
+}
+
+// ErrorCodeOf returns the classification of an error returned by this package.
+func ErrorCodeOf(err error) ErrorCode {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code()
+	}
+
+	var e Error
+	if errors.As(err, &e) {
+		return e.Code()
+	}
+
+	return ErrorCodeUnknown
+}
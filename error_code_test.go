@@ -0,0 +1,26 @@
+package resellerclub
+
+import "testing"
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		msg  string
+		code ErrorCode
+	}{
+		{"Invalid auth-userid or api-key", ErrorCodeInvalidAPIKey},
+		{"Insufficient funds in the Reseller account", ErrorCodeInsufficientFunds},
+		{"Invalid Order ID: 123", ErrorCodeInvalidOrderID},
+		{"example.com is not available for registration", ErrorCodeDomainNotAvailable},
+		{"Domain name not available", ErrorCodeDomainNotAvailable},
+		{"This domain is already registered", ErrorCodeDomainNotAvailable},
+		{"Privacy Protection is not available for this TLD", ErrorCodeUnknown},
+		{"Premium DNS is already registered for this order", ErrorCodeUnknown},
+		{"Something went wrong", ErrorCodeUnknown},
+	}
+
+	for _, tt := range tests {
+		if code := classify(tt.msg); code != tt.code {
+			t.Errorf("classify(%q) = %v, want %v", tt.msg, code, tt.code)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package resellerclub
+
+import (
+	"context"
+	"time"
+)
+
+// Logger records the API calls of a Client. Its methods take a message followed by
+// alternating keys and values, so a *slog.Logger can be used directly.
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+}
+
+// WithLogger sets the Logger of the Client.
+// Every call is logged at info level with its path, duration, status and outcome.
+// At debug level the redacted parameters and the response bodies are logged too,
+// with secrets like the Domain Secret redacted.
+func WithLogger(logger Logger) Option {
+	return func(client *Client) {
+		client.logger = logger
+	}
+}
+
+func loggingInterceptor(logger Logger) Interceptor {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *Request) (*Response, error) {
+			logger.Debug("resellerclub request",
+				"method", req.Method,
+				"path", req.Path,
+				"params", req.RedactedParams().Encode(),
+			)
+
+			start := time.Now()
+			res, err := next.Do(ctx, req)
+			duration := time.Since(start)
+
+			keysAndValues := []interface{}{
+				"method", req.Method,
+				"path", req.Path,
+				"duration", duration,
+			}
+
+			if res != nil {
+				keysAndValues = append(keysAndValues, "status", res.StatusCode)
+
+				logger.Debug("resellerclub response",
+					"method", req.Method,
+					"path", req.Path,
+					"status", res.StatusCode,
+					"body", string(redactFields(res.Body)),
+				)
+			}
+
+			callErr := err
+			if callErr == nil && res != nil {
+				callErr = res.Err
+			}
+
+			if callErr != nil {
+				keysAndValues = append(keysAndValues,
+					"outcome", "error",
+					"error", callErr.Error(),
+					"code", ErrorCodeOf(callErr).String(),
+				)
+			} else {
+				keysAndValues = append(keysAndValues, "outcome", "ok")
+			}
+
+			logger.Info("resellerclub call", keysAndValues...)
+
+			return res, err
+		})
+	}
+}
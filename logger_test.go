@@ -0,0 +1,58 @@
+package resellerclub_test
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/saulortega/resellerclub"
+	"github.com/saulortega/resellerclub/resellerclubtest"
+)
+
+type testLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *testLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.log(msg, keysAndValues)
+}
+
+func (l *testLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.log(msg, keysAndValues)
+}
+
+func (l *testLogger) log(msg string, keysAndValues []interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.lines = append(l.lines, fmt.Sprint(append([]interface{}{msg}, keysAndValues...)...))
+}
+
+func TestLoggerRedactsSecrets(t *testing.T) {
+	s := resellerclubtest.NewServer()
+	defer s.Close()
+	id := s.AddOrder(resellerclubtest.Order{DomainName: "example.com", DomainSecret: "Tr@nsfer\"Secret"})
+
+	logger := &testLogger{}
+	client := s.Client(resellerclub.WithLogger(logger))
+
+	res, err := client.Domains.GetOrderDetails(id, resellerclub.OrderDetailsOptionAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.DomainSecret != "Tr@nsfer\"Secret" {
+		t.Errorf("got DomainSecret %q, the response must not be redacted", res.DomainSecret)
+	}
+
+	logs := strings.Join(logger.lines, "\n")
+	for _, secret := range []string{s.APIKey, "Tr@nsfer", "Secret"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("logs hold %q:\n%v", secret, logs)
+		}
+	}
+	if !strings.Contains(logs, `"domsecret":"REDACTED"`) {
+		t.Errorf("logs do not hold the redacted domsecret:\n%v", logs)
+	}
+}
@@ -1,5 +1,6 @@
 package resellerclub
 
 const (
-	endpoint = "https://httpapi.com/api"
+	endpoint     = "https://httpapi.com/api"
+	testEndpoint = "https://test.httpapi.com/api"
 )
@@ -0,0 +1,218 @@
+package resellerclub
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownAccount is returned by Manager when no Client is registered for an account.
+var ErrUnknownAccount = errors.New("unknown account")
+
+// Manager holds the Clients of several ResellerClub accounts, by account key, e.g. per brand
+// or currency. Every Client keeps its own credentials, rate limits and metrics.
+// It is safe for concurrent use.
+type Manager struct {
+	// Optional. Returns the Metrics of the Client of each account added with AddAccount,
+	// e.g. a resellerclubprom.Collector labelled with the account.
+	AccountMetrics func(account string) Metrics
+
+	opts    []Option
+	mu      sync.RWMutex
+	clients map[string]*Client
+}
+
+// AccountError is the error of a call made for an account by a Manager.
+type AccountError struct {
+	Account string
+	Err     error
+}
+
+func (e *AccountError) Error() string {
+	return e.Account + ": " + e.Err.Error()
+}
+
+func (e *AccountError) Unwrap() error {
+	return e.Err
+}
+
+// AccountErrors gathers the errors of the accounts whose calls failed in a fan-out.
+type AccountErrors []*AccountError
+
+func (e AccountErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Is reports whether the error of any account matches target, so errors.Is works with any Go version.
+func (e AccountErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Unwrap returns the errors of the accounts. errors.As only looks into them from Go 1.20;
+// with older versions, use errors.As with an AccountErrors target and check each AccountError.
+func (e AccountErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// NewManager returns an empty Manager. The given options are applied to every Client created with AddAccount,
+// before the options of the account. WithRateLimit, WithEndpointRateLimit and WithMemoryCache give each account
+// its own limiter and cache; WithRateLimiter and WithCache share theirs between the accounts.
+func NewManager(opts ...Option) *Manager {
+	return &Manager{
+		opts:    opts,
+		clients: map[string]*Client{},
+	}
+}
+
+// AddAccount creates and registers the Client of an account, replacing any previous one.
+func (m *Manager) AddAccount(account string, userID string, key string, opts ...Option) *Client {
+	var clientOpts []Option
+	clientOpts = append(clientOpts, m.opts...)
+	if m.AccountMetrics != nil {
+		clientOpts = append(clientOpts, WithMetrics(m.AccountMetrics(account)))
+	}
+	clientOpts = append(clientOpts, opts...)
+
+	client := New(userID, key, clientOpts...)
+	m.AddClient(account, client)
+
+	return client
+}
+
+// AddClient registers the Client of an account, replacing any previous one.
+func (m *Manager) AddClient(account string, client *Client) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.clients[account] = client
+}
+
+// Remove unregisters the Client of an account.
+func (m *Manager) Remove(account string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.clients, account)
+}
+
+// Client returns the Client of an account.
+func (m *Manager) Client(account string) (*Client, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	client, ok := m.clients[account]
+	if !ok {
+		return nil, &AccountError{account, ErrUnknownAccount}
+	}
+
+	return client, nil
+}
+
+// Accounts returns the sorted keys of the registered accounts.
+func (m *Manager) Accounts() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	accounts := make([]string, 0, len(m.clients))
+	for account := range m.clients {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+
+	return accounts
+}
+
+// ForEach calls fn concurrently for every account. The errors of fn are returned as AccountErrors.
+func (m *Manager) ForEach(ctx context.Context, fn func(ctx context.Context, account string, client *Client) error) error {
+	m.mu.RLock()
+	clients := make(map[string]*Client, len(m.clients))
+	for account, client := range m.clients {
+		clients[account] = client
+	}
+	m.mu.RUnlock()
+
+	var mu sync.Mutex
+	var errs AccountErrors
+	var wg sync.WaitGroup
+	for account, client := range clients {
+		wg.Add(1)
+		go func(account string, client *Client) {
+			defer wg.Done()
+
+			if err := fn(ctx, account, client); err != nil {
+				mu.Lock()
+				errs = append(errs, &AccountError{account, err})
+				mu.Unlock()
+			}
+		}(account, client)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool {
+			return errs[i].Account < errs[j].Account
+		})
+		return errs
+	}
+
+	return nil
+}
+
+// AccountDomainSearchResponseItem is a DomainSearchResponseItem tagged with its account.
+type AccountDomainSearchResponseItem struct {
+	Account string
+	*DomainSearchResponseItem
+}
+
+// SearchDomains runs Domains.Search with the same params on every account and merges the results,
+// sorted by domain name. The results of the accounts that succeeded are returned even if others failed,
+// along with their AccountErrors.
+func (m *Manager) SearchDomains(ctx context.Context, params *DomainSearchParams) ([]*AccountDomainSearchResponseItem, error) {
+	if params == nil {
+		return nil, ErrMissingParams
+	}
+
+	var mu sync.Mutex
+	var items []*AccountDomainSearchResponseItem
+	err := m.ForEach(ctx, func(ctx context.Context, account string, client *Client) error {
+		res, err := client.Domains.SearchContext(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, item := range res {
+			items = append(items, &AccountDomainSearchResponseItem{account, item})
+		}
+
+		return nil
+	})
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Description != items[j].Description {
+			return items[i].Description < items[j].Description
+		}
+		return items[i].Account < items[j].Account
+	})
+
+	return items, err
+}
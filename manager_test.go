@@ -0,0 +1,77 @@
+package resellerclub_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/saulortega/resellerclub"
+	"github.com/saulortega/resellerclub/resellerclubtest"
+)
+
+func TestManagerAccountsDoNotShareLimiterOrCache(t *testing.T) {
+	a, b := resellerclubtest.NewServer(), resellerclubtest.NewServer()
+	defer a.Close()
+	defer b.Close()
+
+	idA := a.AddOrder(resellerclubtest.Order{DomainName: "owner-a.com"})
+	idB := b.AddOrder(resellerclubtest.Order{DomainName: "owner-b.com"})
+	if idA != idB {
+		t.Fatalf("order IDs differ: %v and %v", idA, idB)
+	}
+
+	m := resellerclub.NewManager(
+		resellerclub.WithMemoryCache(100),
+		resellerclub.WithRateLimit(resellerclub.RateLimit{Rate: 0.001, Burst: 1}),
+		resellerclub.WithRateLimitMode(resellerclub.RateLimitFailFast),
+		resellerclub.WithoutRetries(),
+	)
+	m.AddAccount("a", a.UserID, a.APIKey, resellerclub.WithEndpoint(a.URL))
+	m.AddAccount("b", b.UserID, b.APIKey, resellerclub.WithEndpoint(b.URL))
+
+	for _, tt := range []struct {
+		account string
+		want    string
+	}{
+		{"a", "owner-a.com"},
+		{"b", "owner-b.com"},
+	} {
+		client, err := m.Client(tt.account)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := client.Domains.GetOrderDetails(idA)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.account, err)
+		}
+		if res.DomainName != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.account, res.DomainName, tt.want)
+		}
+	}
+
+	// The only token of account a is spent; cached responses don't need one.
+	client, _ := m.Client("a")
+	if _, err := client.Domains.GetOrderID("owner-a.com"); !errors.Is(err, resellerclub.ErrRateLimited) {
+		t.Errorf("got %v, want ErrRateLimited", err)
+	}
+}
+
+func TestManagerAccountErrorsIs(t *testing.T) {
+	s := resellerclubtest.NewServer()
+	defer s.Close()
+
+	m := resellerclub.NewManager(resellerclub.WithEndpoint(s.URL), resellerclub.WithoutRetries())
+	m.AddAccount("good", s.UserID, s.APIKey)
+	m.AddAccount("bad", s.UserID, "wrong-api-key")
+
+	_, err := m.SearchDomains(context.Background(), &resellerclub.DomainSearchParams{NoOfRecords: 10, PageNo: 1})
+	if !errors.Is(err, resellerclub.ErrInvalidAPIKey) {
+		t.Errorf("got %v, want ErrInvalidAPIKey", err)
+	}
+
+	var errs resellerclub.AccountErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Account != "bad" {
+		t.Errorf("got %v, want the AccountErrors of account bad", err)
+	}
+}
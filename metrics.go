@@ -0,0 +1,45 @@
+package resellerclub
+
+import (
+	"context"
+	"time"
+)
+
+// Metrics receives measurements of the API calls made by a Client.
+// The resellerclubprom package provides an implementation exposing them to Prometheus.
+type Metrics interface {
+	// ObserveCall is called once per API call, whatever the number of attempts.
+	// err is the error of the call, if any; ErrorCodeOf classifies it.
+	ObserveCall(path string, duration time.Duration, err error)
+
+	// ObserveRetry is called every time a failed attempt is going to be retried.
+	ObserveRetry(path string)
+
+	// ObserveRateLimitWait is called when a call had to wait for the rate limiter.
+	ObserveRateLimitWait(path string, wait time.Duration)
+}
+
+// WithMetrics sets the Metrics of the Client.
+func WithMetrics(metrics Metrics) Option {
+	return func(client *Client) {
+		client.metrics = metrics
+	}
+}
+
+func metricsInterceptor(metrics Metrics) Interceptor {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *Request) (*Response, error) {
+			start := time.Now()
+			res, err := next.Do(ctx, req)
+
+			callErr := err
+			if callErr == nil && res != nil {
+				callErr = res.Err
+			}
+
+			metrics.ObserveCall(req.Path, time.Since(start), callErr)
+
+			return res, err
+		})
+	}
+}
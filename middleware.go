@@ -0,0 +1,59 @@
+package resellerclub
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Request is the structured view of an API call passed through the interceptors of a Client.
+type Request struct {
+	Method string      // HTTP method: GET or POST
+	Path   string      // API path relative to the endpoint, e.g. /domains/details.json
+	Params url.Values  // Parameters of the call. The credentials are added after the interceptors.
+	Header http.Header // HTTP headers sent with the call
+}
+
+// Response is the structured view of an API response passed through the interceptors of a Client.
+type Response struct {
+	StatusCode int         // HTTP status code
+	Header     http.Header // HTTP headers of the response
+	Body       []byte      // Body of the response, with the credentials and secret parameters scrubbed
+	Err        error       // Error returned by the API in the body, if any
+
+	// Body as received, decoded into the result of the call. An interceptor that rewrites
+	// the body returns a new Response, which leaves it nil so that Body is decoded instead.
+	raw []byte
+}
+
+// Doer performs API calls.
+type Doer interface {
+	Do(ctx context.Context, req *Request) (*Response, error)
+}
+
+// DoerFunc is an adapter to allow the use of ordinary functions as Doers.
+type DoerFunc func(ctx context.Context, req *Request) (*Response, error)
+
+// Do calls f(ctx, req).
+func (f DoerFunc) Do(ctx context.Context, req *Request) (*Response, error) {
+	return f(ctx, req)
+}
+
+// Interceptor wraps a Doer to observe or modify the API calls that pass through it.
+type Interceptor func(next Doer) Doer
+
+// WithInterceptors adds interceptors to the Client. Every API call passes through them,
+// the first one being the outermost. They see each call once, whatever the number of retries.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(client *Client) {
+		client.interceptors = append(client.interceptors, interceptors...)
+	}
+}
+
+func chain(doer Doer, interceptors []Interceptor) Doer {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		doer = interceptors[i](doer)
+	}
+
+	return doer
+}
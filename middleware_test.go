@@ -0,0 +1,36 @@
+package resellerclub_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/saulortega/resellerclub"
+	"github.com/saulortega/resellerclub/resellerclubtest"
+)
+
+func TestInterceptorsDoNotSeeCredentials(t *testing.T) {
+	s := resellerclubtest.NewServer()
+	defer s.Close()
+	id := s.AddOrder(resellerclubtest.Order{DomainName: "example.com"})
+
+	var seen []*resellerclub.Request
+	client := s.Client(resellerclub.WithInterceptors(func(next resellerclub.Doer) resellerclub.Doer {
+		return resellerclub.DoerFunc(func(ctx context.Context, req *resellerclub.Request) (*resellerclub.Response, error) {
+			seen = append(seen, req)
+			return next.Do(ctx, req)
+		})
+	}))
+
+	if _, err := client.Domains.GetOrderDetails(id); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(seen) != 1 {
+		t.Fatalf("got %v calls, want 1", len(seen))
+	}
+	for _, k := range []string{"auth-userid", "api-key"} {
+		if _, ok := seen[0].Params[k]; ok {
+			t.Errorf("interceptor saw %v in the parameters", k)
+		}
+	}
+}
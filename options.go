@@ -0,0 +1,77 @@
+package resellerclub
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to perform the API calls.
+// By default http.DefaultClient is used.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(client *Client) {
+		if httpClient != nil {
+			client.httpClient = httpClient
+		}
+	}
+}
+
+// WithTimeout sets a time limit for every API call.
+// The HTTP client passed with WithHTTPClient is not modified; a copy with the timeout is used instead.
+func WithTimeout(timeout time.Duration) Option {
+	return func(client *Client) {
+		client.timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every API call.
+func WithUserAgent(userAgent string) Option {
+	return func(client *Client) {
+		client.userAgent = userAgent
+	}
+}
+
+// WithPostParamsInQuery sends the parameters of POST calls (Register, Renew, Customers.Create...)
+// in the query string, as older versions of this package did, instead of a form-encoded body.
+// It exposes the API key and passwords to proxy and access logs, so it should only be used
+// when an intermediary requires it.
+func WithPostParamsInQuery() Option {
+	return func(client *Client) {
+		client.postParamsInQuery = true
+	}
+}
+
+// WithEndpoint sets the base URL of the API, e.g. https://httpapi.com/api.
+// If the URL is not a valid absolute http(s) URL, every call of the Client fails with ErrInvalidEndpoint,
+// so that a misconfigured Client never falls back to the production endpoint.
+func WithEndpoint(rawURL string) Option {
+	return func(client *Client) {
+		if err := validateEndpoint(rawURL); err != nil {
+			client.endpointErr = err
+			return
+		}
+
+		client.endpoint = strings.TrimSuffix(rawURL, "/")
+		client.endpointErr = nil
+	}
+}
+
+func validateEndpoint(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return fmt.Errorf("%w: %q", ErrInvalidEndpoint, rawURL)
+	}
+
+	return nil
+}
+
+// WithTestMode points the Client to the ResellerClub test environment (test.httpapi.com).
+// Orders placed in test mode are not executed at the Registries.
+func WithTestMode() Option {
+	return WithEndpoint(testEndpoint)
+}
@@ -0,0 +1,38 @@
+package resellerclub
+
+import (
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestWithEndpointInvalid(t *testing.T) {
+	for _, rawURL := range []string{"test.httpapi.com/api", "ftp://test.httpapi.com/api", "://"} {
+		client := New("123456", "secret-api-key", WithEndpoint(rawURL))
+
+		_, err := client.Domains.GetOrderID("example.com")
+		if !errors.Is(err, ErrInvalidEndpoint) {
+			t.Errorf("WithEndpoint(%q): got error %v, want ErrInvalidEndpoint", rawURL, err)
+		}
+	}
+}
+
+func TestNewFromEnvInvalidEndpoint(t *testing.T) {
+	for k, v := range map[string]string{
+		EnvUserID:   "123456",
+		EnvAPIKey:   "secret-api-key",
+		EnvEndpoint: "test.httpapi.com/api",
+	} {
+		defer os.Setenv(k, os.Getenv(k))
+		os.Setenv(k, v)
+	}
+
+	if _, err := NewFromEnv(); !errors.Is(err, ErrInvalidEndpoint) {
+		t.Errorf("got error %v, want ErrInvalidEndpoint", err)
+	}
+
+	profile := Profile{UserID: "123456", APIKey: "secret-api-key", Endpoint: "test.httpapi.com/api"}
+	if _, err := profile.Client(); !errors.Is(err, ErrInvalidEndpoint) {
+		t.Errorf("Profile.Client: got error %v, want ErrInvalidEndpoint", err)
+	}
+}
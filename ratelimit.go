@@ -0,0 +1,192 @@
+package resellerclub
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned in fail-fast mode when a call exceeds the rate limit of the Client.
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// RateLimitMode defines what happens when a call exceeds the rate limit.
+type RateLimitMode int
+
+const (
+	// RateLimitBlock waits until the call is allowed or the context is done.
+	RateLimitBlock RateLimitMode = iota
+
+	// RateLimitFailFast returns ErrRateLimited immediately.
+	RateLimitFailFast
+)
+
+// RateLimit defines a token bucket limit.
+type RateLimit struct {
+	// Sustained number of calls per second. Zero or negative means no limit.
+	Rate float64
+
+	// Maximum number of calls allowed at once. Values lower than 1 are treated as 1.
+	Burst int
+}
+
+// RateLimiter is a token bucket limiter. It is safe for concurrent use,
+// so the same RateLimiter can be shared by several Clients.
+type RateLimiter struct {
+	mu     sync.Mutex
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter with a full bucket.
+func NewRateLimiter(limit RateLimit) *RateLimiter {
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+
+	return &RateLimiter{
+		limit:  limit,
+		tokens: float64(limit.Burst),
+		last:   time.Now(),
+	}
+}
+
+// Allow takes a token if one is available and tells whether it did.
+func (l *RateLimiter) Allow() bool {
+	_, ok := l.take()
+	return ok
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	_, err := l.wait(ctx)
+	return err
+}
+
+// wait is like Wait but it also returns how long it waited.
+func (l *RateLimiter) wait(ctx context.Context) (time.Duration, error) {
+	var waited time.Duration
+	for {
+		wait, ok := l.take()
+		if ok {
+			return waited, nil
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return waited, err
+		}
+		waited += wait
+	}
+}
+
+// take takes a token if one is available. Otherwise it returns how long until one will be.
+func (l *RateLimiter) take() (time.Duration, bool) {
+	if l.limit.Rate <= 0 {
+		return 0, true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = math.Min(float64(l.limit.Burst), l.tokens+now.Sub(l.last).Seconds()*l.limit.Rate)
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0, true
+	}
+
+	return time.Duration((1 - l.tokens) / l.limit.Rate * float64(time.Second)), false
+}
+
+// putBack returns a token taken for a call that was not sent.
+func (l *RateLimiter) putBack() {
+	if l.limit.Rate <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(float64(l.limit.Burst), l.tokens+1)
+}
+
+// WithRateLimit limits the rate of the calls made by the Client, whatever the sub-service.
+// Every Client the option is applied to gets its own RateLimiter; use WithRateLimiter to share one.
+func WithRateLimit(limit RateLimit) Option {
+	return func(client *Client) {
+		client.rateLimiter = NewRateLimiter(limit)
+	}
+}
+
+// WithRateLimiter sets the RateLimiter of the Client. It allows several Clients to share the same limit.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(client *Client) {
+		client.rateLimiter = limiter
+	}
+}
+
+// WithEndpointRateLimit limits the rate of the calls to the given API path, e.g. /domains/available.json.
+// It applies in addition to the limit set with WithRateLimit.
+func WithEndpointRateLimit(path string, limit RateLimit) Option {
+	return func(client *Client) {
+		if client.endpointRateLimiters == nil {
+			client.endpointRateLimiters = map[string]*RateLimiter{}
+		}
+
+		client.endpointRateLimiters[path] = NewRateLimiter(limit)
+	}
+}
+
+// WithRateLimitMode sets what happens when a call exceeds the rate limit. By default it blocks.
+func WithRateLimitMode(mode RateLimitMode) Option {
+	return func(client *Client) {
+		client.rateLimitMode = mode
+	}
+}
+
+// waitRateLimit applies the rate limits of the Client to a call to path.
+// If any limit refuses the call, the tokens taken from the others are put back.
+func (client *Client) waitRateLimit(ctx context.Context, path string) error {
+	var waited time.Duration
+	defer func() {
+		if waited > 0 && client.metrics != nil {
+			client.metrics.ObserveRateLimitWait(path, waited)
+		}
+	}()
+
+	var taken []*RateLimiter
+	putBack := func() {
+		for _, limiter := range taken {
+			limiter.putBack()
+		}
+	}
+
+	for _, limiter := range []*RateLimiter{client.endpointRateLimiters[path], client.rateLimiter} {
+		if limiter == nil {
+			continue
+		}
+
+		if client.rateLimitMode == RateLimitFailFast {
+			if !limiter.Allow() {
+				putBack()
+				return ErrRateLimited
+			}
+			taken = append(taken, limiter)
+			continue
+		}
+
+		wait, err := limiter.wait(ctx)
+		waited += wait
+		if err != nil {
+			putBack()
+			return err
+		}
+		taken = append(taken, limiter)
+	}
+
+	return nil
+}
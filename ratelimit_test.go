@@ -0,0 +1,131 @@
+package resellerclub
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurst(t *testing.T) {
+	l := NewRateLimiter(RateLimit{Rate: 0.001, Burst: 3})
+
+	for i := 0; i < 3; i++ {
+		if !l.Allow() {
+			t.Fatalf("call %v refused within the burst", i+1)
+		}
+	}
+	if l.Allow() {
+		t.Error("call allowed beyond the burst")
+	}
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	l := NewRateLimiter(RateLimit{Rate: 2, Burst: 2})
+	l.Allow()
+	l.Allow()
+	if l.Allow() {
+		t.Fatal("call allowed with an empty bucket")
+	}
+
+	// Half a second later one token is back, and only one.
+	l.mu.Lock()
+	l.last = l.last.Add(-500 * time.Millisecond)
+	l.mu.Unlock()
+
+	if !l.Allow() {
+		t.Error("token not refilled")
+	}
+	if l.Allow() {
+		t.Error("more tokens refilled than the rate allows")
+	}
+
+	// The bucket never holds more than the burst.
+	l.mu.Lock()
+	l.last = l.last.Add(-time.Hour)
+	l.mu.Unlock()
+
+	for i := 0; i < 2; i++ {
+		if !l.Allow() {
+			t.Fatalf("call %v refused with a full bucket", i+1)
+		}
+	}
+	if l.Allow() {
+		t.Error("bucket refilled beyond the burst")
+	}
+}
+
+func TestRateLimiterNoLimit(t *testing.T) {
+	l := NewRateLimiter(RateLimit{})
+
+	for i := 0; i < 100; i++ {
+		if !l.Allow() {
+			t.Fatal("call refused without a limit")
+		}
+	}
+}
+
+func TestRateLimiterWaitContext(t *testing.T) {
+	l := NewRateLimiter(RateLimit{Rate: 0.001, Burst: 1})
+	l.Allow()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want context.DeadlineExceeded", err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("Wait returned after %v, not when the context was done", d)
+	}
+}
+
+func TestWaitRateLimitFailFastPutsTokensBack(t *testing.T) {
+	const path = "/domains/available.json"
+
+	client := New("123456", "secret-api-key",
+		WithRateLimit(RateLimit{Rate: 0.001, Burst: 1}),
+		WithEndpointRateLimit(path, RateLimit{Rate: 0.001, Burst: 1}),
+		WithRateLimitMode(RateLimitFailFast),
+	)
+	ctx := context.Background()
+
+	// Another path spends the only global token.
+	if err := client.waitRateLimit(ctx, "/domains/details.json"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.waitRateLimit(ctx, path); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("got %v, want ErrRateLimited", err)
+	}
+
+	// The endpoint token was not spent on the refused call.
+	client.rateLimiter.mu.Lock()
+	client.rateLimiter.last = client.rateLimiter.last.Add(-time.Hour)
+	client.rateLimiter.mu.Unlock()
+
+	if err := client.waitRateLimit(ctx, path); err != nil {
+		t.Errorf("endpoint token lost on a refused call: %v", err)
+	}
+}
+
+func TestWaitRateLimitBlockingPutsTokensBack(t *testing.T) {
+	const path = "/domains/available.json"
+
+	client := New("123456", "secret-api-key",
+		WithRateLimit(RateLimit{Rate: 0.001, Burst: 1}),
+		WithEndpointRateLimit(path, RateLimit{Rate: 0.001, Burst: 1}),
+	)
+	client.rateLimiter.Allow()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := client.waitRateLimit(ctx, path); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+	if !client.endpointRateLimiters[path].Allow() {
+		t.Error("endpoint token lost on a cancelled call")
+	}
+}
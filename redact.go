@@ -0,0 +1,130 @@
+package resellerclub
+
+import (
+	"bytes"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+// sensitiveParams are the parameters whose values never show up in errors, logs or Request.String.
+var sensitiveParams = map[string]bool{
+	"api-key":   true,
+	"passwd":    true,
+	"auth-code": true,
+}
+
+// sensitiveFields are the fields of the responses whose values never show up in logs,
+// like the Domain Secret (auth code) answered by GetOrderDetails.
+var sensitiveFields = []string{"domsecret"}
+
+// sensitiveFieldsRegexp matches a sensitive field of a JSON object and its string value.
+var sensitiveFieldsRegexp = regexp.MustCompile(`("(?:` + strings.Join(sensitiveFields, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// minSecretLen is the minimum length of a value to be scrubbed from errors and response bodies.
+// Shorter values would match innocent substrings.
+const minSecretLen = 6
+
+// RedactedParams returns a copy of the parameters with the API key, passwords and auth codes redacted.
+func (req *Request) RedactedParams() url.Values {
+	params := url.Values{}
+	for k, v := range req.Params {
+		if sensitiveParams[k] {
+			params.Set(k, redacted)
+			continue
+		}
+
+		params[k] = append([]string(nil), v...)
+	}
+
+	return params
+}
+
+// String returns the method, path and parameters of the call with the API key, passwords and auth codes redacted.
+func (req *Request) String() string {
+	return req.Method + " " + req.Path + "?" + req.RedactedParams().Encode()
+}
+
+// secrets returns the values of the sensitive parameters of the call.
+func (req *Request) secrets() []string {
+	var secrets []string
+	for k, v := range req.Params {
+		if !sensitiveParams[k] {
+			continue
+		}
+
+		for _, s := range v {
+			if len(s) >= minSecretLen {
+				secrets = append(secrets, s, url.QueryEscape(s))
+			}
+		}
+	}
+
+	return secrets
+}
+
+// secrets returns the API key, to be scrubbed from errors and response bodies.
+func (credentials Credentials) secrets() []string {
+	if len(credentials.APIKey) < minSecretLen {
+		return nil
+	}
+
+	return []string{credentials.APIKey, url.QueryEscape(credentials.APIKey)}
+}
+
+func redactString(s string, secrets []string) string {
+	for _, secret := range secrets {
+		s = strings.Replace(s, secret, redacted, -1)
+	}
+
+	return s
+}
+
+// redactFields replaces the values of the sensitive fields of a JSON body.
+func redactFields(body []byte) []byte {
+	return sensitiveFieldsRegexp.ReplaceAll(body, []byte(`${1}"`+redacted+`"`))
+}
+
+func redactBytes(b []byte, secrets []string) []byte {
+	for _, secret := range secrets {
+		b = bytes.Replace(b, []byte(secret), []byte(redacted), -1)
+	}
+
+	return b
+}
+
+// redactedError is an error whose message had secrets scrubbed from it.
+// It does not unwrap to the original error, which still holds them.
+type redactedError struct {
+	msg string
+}
+
+func (e *redactedError) Error() string {
+	return e.msg
+}
+
+// redactError scrubs secrets from err.
+func redactError(err error, secrets []string) error {
+	if err == nil || len(secrets) == 0 {
+		return err
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return &url.Error{
+			Op:  urlErr.Op,
+			URL: redactString(urlErr.URL, secrets),
+			Err: redactError(urlErr.Err, secrets),
+		}
+	}
+
+	msg := err.Error()
+	if redactString(msg, secrets) == msg {
+		return err
+	}
+
+	return &redactedError{redactString(msg, secrets)}
+}
@@ -0,0 +1,133 @@
+package resellerclub_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/saulortega/resellerclub"
+	"github.com/saulortega/resellerclub/resellerclubtest"
+)
+
+func TestRedactErrorBodies(t *testing.T) {
+	s := resellerclubtest.NewServer()
+	defer s.Close()
+	client := s.Client()
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"api error", `{"status":"ERROR","message":"Invalid api-key ` + s.APIKey + `"}`},
+		{"errorvalue", `{"errorvalue":{"error":"Invalid api-key ` + s.APIKey + `"}}`},
+		{"html", `<html><body>Bad gateway for api-key=` + s.APIKey + `</body></html>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s.FailNext("/domains/details.json", http.StatusInternalServerError, tt.body)
+
+			_, err := client.Domains.GetOrderDetails(1)
+			if err == nil {
+				t.Fatal("got no error")
+			}
+			assertRedacted(t, "error", err.Error(), s.APIKey)
+
+			var httpErr *resellerclub.HTTPError
+			if !errors.As(err, &httpErr) {
+				t.Fatalf("got %T, want *HTTPError", err)
+			}
+			assertRedacted(t, "HTTPError.Body", httpErr.Body, s.APIKey)
+
+			var apiErr resellerclub.Error
+			if errors.As(err, &apiErr) {
+				assertRedacted(t, "Error.Response", fmt.Sprintf("%+v", apiErr.Response()), s.APIKey)
+			}
+		})
+	}
+}
+
+func TestRedactDialError(t *testing.T) {
+	const key = "dial-error-api-key"
+
+	for _, opts := range [][]resellerclub.Option{nil, {resellerclub.WithPostParamsInQuery()}} {
+		opts = append(opts, resellerclub.WithEndpoint("http://127.0.0.1:1/api"), resellerclub.WithoutRetries())
+		client := resellerclub.New("123456", key, opts...)
+
+		_, err := client.Domains.GetOrderDetails(1)
+		if err == nil {
+			t.Fatal("got no error")
+		}
+		assertRedacted(t, "GET error", err.Error(), key)
+
+		_, err = client.Domains.Renew(&resellerclub.DomainRenewParams{OrderID: 1, Years: 1})
+		if err == nil {
+			t.Fatal("got no error")
+		}
+		assertRedacted(t, "POST error", err.Error(), key)
+	}
+}
+
+func TestRedactKeepsDecodedValues(t *testing.T) {
+	s := resellerclubtest.NewServer()
+	defer s.Close()
+
+	var body []byte
+	client := s.Client(resellerclub.WithInterceptors(func(next resellerclub.Doer) resellerclub.Doer {
+		return resellerclub.DoerFunc(func(ctx context.Context, req *resellerclub.Request) (*resellerclub.Response, error) {
+			res, err := next.Do(ctx, req)
+			if res != nil {
+				body = res.Body
+			}
+			return res, err
+		})
+	}))
+
+	// The auth code is a secret of the call, and a substring of the order ID in the response.
+	s.FailNext("/domains/transfer.json", http.StatusOK, `{"entityid":"12345678","description":"example.com","actionstatus":"Success"}`)
+
+	res, err := client.Domains.Transfer(&resellerclub.DomainTransferParams{
+		DomainName:       "example.com",
+		AuthCode:         "345678",
+		CustomerID:       1,
+		RegContactID:     1,
+		AdminContactID:   1,
+		TechContactID:    1,
+		BillingContactID: 1,
+		InvoiceOption:    "NoInvoice",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.EntityID != 12345678 {
+		t.Errorf("got order %v, want 12345678", res.EntityID)
+	}
+	assertRedacted(t, "Response.Body", string(body), "345678")
+}
+
+func TestRedactedParams(t *testing.T) {
+	req := &resellerclub.Request{
+		Method: http.MethodPost,
+		Path:   "/customers/v2/signup.json",
+		Params: map[string][]string{"username": {"jane@example.com"}, "passwd": {"s3cret-passwd"}},
+	}
+
+	assertRedacted(t, "String", req.String(), "s3cret-passwd")
+	if got := req.RedactedParams().Get("username"); got != "jane@example.com" {
+		t.Errorf("username: got %q", got)
+	}
+	if got := req.Params.Get("passwd"); got != "s3cret-passwd" {
+		t.Errorf("RedactedParams modified the parameters: passwd is %q", got)
+	}
+}
+
+func assertRedacted(t *testing.T, what string, s string, secret string) {
+	t.Helper()
+
+	if strings.Contains(s, secret) {
+		t.Errorf("%v holds the secret: %v", what, s)
+	}
+}
@@ -0,0 +1,107 @@
+// Package resellerclubprom exposes the metrics of a resellerclub.Client to Prometheus.
+// It is a module of its own, so that the resellerclub package does not depend on Prometheus.
+//
+//	collector := resellerclubprom.NewCollector(nil)
+//	prometheus.MustRegister(collector)
+//	client := resellerclub.New("123456", "asdfghjklqwertyuiopzxcvbnm", resellerclub.WithMetrics(collector))
+package resellerclubprom
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/saulortega/resellerclub"
+)
+
+const namespace = "resellerclub"
+
+// Collector implements resellerclub.Metrics and prometheus.Collector.
+// The same Collector can be shared by several Clients.
+type Collector struct {
+	calls          *prometheus.CounterVec
+	errors         *prometheus.CounterVec
+	duration       *prometheus.HistogramVec
+	retries        *prometheus.CounterVec
+	rateLimitWaits *prometheus.HistogramVec
+}
+
+// NewCollector returns a Collector whose metrics carry the given constant labels, which may be nil.
+func NewCollector(constLabels prometheus.Labels) *Collector {
+	return &Collector{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "calls_total",
+			Help:        "Number of API calls, whatever the number of attempts.",
+			ConstLabels: constLabels,
+		}, []string{"path"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "errors_total",
+			Help:        "Number of failed API calls by error code.",
+			ConstLabels: constLabels,
+		}, []string{"path", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Name:        "call_duration_seconds",
+			Help:        "Duration of the API calls, including retries and rate limit waits.",
+			ConstLabels: constLabels,
+			Buckets:     []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+		}, []string{"path"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "retries_total",
+			Help:        "Number of retried attempts.",
+			ConstLabels: constLabels,
+		}, []string{"path"}),
+		rateLimitWaits: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Name:        "rate_limit_wait_seconds",
+			Help:        "Time spent waiting for the client-side rate limiter.",
+			ConstLabels: constLabels,
+			Buckets:     []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		}, []string{"path"}),
+	}
+}
+
+// ObserveCall implements resellerclub.Metrics.
+func (c *Collector) ObserveCall(path string, duration time.Duration, err error) {
+	c.calls.WithLabelValues(path).Inc()
+	c.duration.WithLabelValues(path).Observe(duration.Seconds())
+
+	if err != nil {
+		c.errors.WithLabelValues(path, resellerclub.ErrorCodeOf(err).String()).Inc()
+	}
+}
+
+// ObserveRetry implements resellerclub.Metrics.
+func (c *Collector) ObserveRetry(path string) {
+	c.retries.WithLabelValues(path).Inc()
+}
+
+// ObserveRateLimitWait implements resellerclub.Metrics.
+func (c *Collector) ObserveRateLimitWait(path string, wait time.Duration) {
+	c.rateLimitWaits.WithLabelValues(path).Observe(wait.Seconds())
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.calls.Describe(ch)
+	c.errors.Describe(ch)
+	c.duration.Describe(ch)
+	c.retries.Describe(ch)
+	c.rateLimitWaits.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.calls.Collect(ch)
+	c.errors.Collect(ch)
+	c.duration.Collect(ch)
+	c.retries.Collect(ch)
+	c.rateLimitWaits.Collect(ch)
+}
+
+var (
+	_ resellerclub.Metrics = (*Collector)(nil)
+	_ prometheus.Collector = (*Collector)(nil)
+)
@@ -0,0 +1,69 @@
+package resellerclubprom_test
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/saulortega/resellerclub"
+	"github.com/saulortega/resellerclub/resellerclubprom"
+	"github.com/saulortega/resellerclub/resellerclubtest"
+)
+
+func TestCollector(t *testing.T) {
+	collector := resellerclubprom.NewCollector(nil)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	s := resellerclubtest.NewServer()
+	defer s.Close()
+	orderID := s.AddOrder(resellerclubtest.Order{DomainName: "example.com"})
+	client := s.Client(resellerclub.WithMetrics(collector), resellerclub.WithRetryPolicy(resellerclub.RetryPolicy{MaxAttempts: 1}))
+
+	if _, err := client.Domains.GetOrderDetails(orderID, resellerclub.OrderDetailsOptionOrderDetails); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Domains.GetOrderDetails(orderID+1000, resellerclub.OrderDetailsOptionOrderDetails); err == nil {
+		t.Fatal("got no error for an unknown order")
+	}
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := map[string]string{"path": "/domains/details.json"}
+	if got := value(families, "resellerclub_calls_total", path); got != 2 {
+		t.Errorf("got %v calls, want 2", got)
+	}
+
+	path["code"] = resellerclub.ErrorCodeInvalidOrderID.String()
+	if got := value(families, "resellerclub_errors_total", path); got != 1 {
+		t.Errorf("got %v errors with code %v, want 1", got, path["code"])
+	}
+}
+
+// value returns the value of the counter with the given name and labels, or -1 if there is none.
+func value(families []*dto.MetricFamily, name string, labels map[string]string) float64 {
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+	metrics:
+		for _, m := range family.GetMetric() {
+			if len(m.GetLabel()) != len(labels) {
+				continue
+			}
+			for _, l := range m.GetLabel() {
+				if labels[l.GetName()] != l.GetValue() {
+					continue metrics
+				}
+			}
+
+			return m.GetCounter().GetValue()
+		}
+	}
+
+	return -1
+}
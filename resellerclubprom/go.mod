@@ -0,0 +1,9 @@
+module github.com/saulortega/resellerclub/resellerclubprom
+
+go 1.16
+
+require (
+	github.com/prometheus/client_golang v1.9.0
+	github.com/prometheus/client_model v0.2.0
+	github.com/saulortega/resellerclub v0.0.0-20261018062811-69fe93db4c94
+)
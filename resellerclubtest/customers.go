@@ -0,0 +1,42 @@
+package resellerclubtest
+
+import (
+	"net/url"
+	"strings"
+)
+
+func (s *Server) customersSignup(params url.Values) (interface{}, error) {
+	username := strings.ToLower(params.Get("username"))
+	if len(username) == 0 || !strings.Contains(username, "@") {
+		return nil, statusError("Invalid username: it should be an email address")
+	}
+
+	for _, c := range s.customers {
+		if c.Username == username {
+			return nil, statusError("Customer already exists with username " + username)
+		}
+	}
+
+	if l := len(params.Get("passwd")); l < 9 || l > 16 {
+		return nil, statusError("Password should be 9 to 16 characters long")
+	}
+
+	for _, name := range []string{"name", "company", "address-line-1", "city", "state", "country", "zipcode", "phone-cc", "phone", "lang-pref"} {
+		if len(params.Get(name)) == 0 {
+			return nil, statusError(name + " is required")
+		}
+	}
+
+	c := &Customer{
+		ID:       s.newID(),
+		Username: username,
+		Password: params.Get("passwd"),
+		Name:     params.Get("name"),
+		Company:  params.Get("company"),
+		Country:  params.Get("country"),
+		LangPref: params.Get("lang-pref"),
+	}
+	s.customers[c.ID] = c
+
+	return c.ID, nil
+}
@@ -0,0 +1,705 @@
+package resellerclubtest
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/saulortega/resellerclub"
+)
+
+func (s *Server) domainsAvailable(params url.Values) (interface{}, error) {
+	if len(params["domain-name"]) == 0 {
+		return nil, statusError("domain-name is required")
+	}
+	if len(params["tlds"]) == 0 {
+		return nil, statusError("No TLDs are selected")
+	}
+
+	res := map[string]interface{}{}
+	for _, name := range params["domain-name"] {
+		for _, tld := range params["tlds"] {
+			domainName := strings.ToLower(name + "." + tld)
+			res[domainName] = map[string]string{
+				"classkey": productKey(domainName),
+				"status":   s.domainStatus(domainName),
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Server) domainsOrderID(params url.Values) (interface{}, error) {
+	domainName := params.Get("domain-name")
+
+	o, ok := s.orderByDomain(domainName)
+	if !ok {
+		return nil, statusError("Website doesn't exist for " + domainName)
+	}
+
+	return o.ID, nil
+}
+
+func (s *Server) domainsDetails(params url.Values) (interface{}, error) {
+	o, err := s.order(params.Get("order-id"))
+	if err != nil {
+		return nil, err
+	}
+
+	options := map[string]bool{}
+	for _, opt := range params["options"] {
+		options[opt] = true
+	}
+	all := options["All"]
+
+	res := map[string]interface{}{
+		"orderid":                 itoa(o.ID),
+		"entityid":                itoa(o.ID),
+		"description":             o.DomainName,
+		"domainname":              o.DomainName,
+		"currentstatus":           o.Status,
+		"orderstatus":             []string{},
+		"domainstatus":            []string{},
+		"productcategory":         "domorder",
+		"productkey":              o.ProductKey,
+		"classkey":                o.ProductKey,
+		"classname":               "com.logicboxes.foundation.sfnb.order.domorder." + o.ProductKey,
+		"creationtime":            itoa(o.CreationTime.Unix()),
+		"endtime":                 itoa(o.EndTime.Unix()),
+		"customerid":              itoa(o.CustomerID),
+		"parentkey":               "999999999_" + s.UserID,
+		"isImmediateReseller":     "true",
+		"recurring":               strconv.FormatBool(o.AutoRenew),
+		"isprivacyprotected":      strconv.FormatBool(o.PrivacyProtected),
+		"privacyprotectedallowed": "true",
+		"raaVerificationStatus":   "Verified",
+		"allowdeletion":           "true",
+		"gdpr": map[string]string{
+			"enabled":  "true",
+			"eligible": "true",
+		},
+	}
+
+	if all || options["NsDetails"] || options["OrderDetails"] {
+		res["noOfNameServers"] = strconv.Itoa(len(o.NS))
+		for i, ns := range o.NS {
+			res["ns"+strconv.Itoa(i+1)] = ns
+		}
+		if len(o.CNS) > 0 {
+			res["cns"] = o.CNS
+		}
+	}
+
+	if all || options["OrderDetails"] {
+		res["domsecret"] = o.DomainSecret
+	}
+
+	contacts := []struct {
+		name   string
+		id     int64
+		option string
+	}{
+		{"registrant", o.RegContactID, "RegistrantContactDetails"},
+		{"admin", o.AdminContactID, "AdminContactDetails"},
+		{"tech", o.TechContactID, "TechContactDetails"},
+		{"billing", o.BillingContactID, "BillingContactDetails"},
+	}
+	for _, c := range contacts {
+		if all || options["ContactIds"] {
+			res[c.name+"contactid"] = itoa(c.id)
+		}
+		if all || options[c.option] {
+			if contact, ok := s.contacts[c.id]; ok {
+				res[c.name+"contact"] = contactJSON(contact)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Server) domainsSearch(params url.Values) (interface{}, error) {
+	noOfRecords, _ := strconv.Atoi(params.Get("no-of-records"))
+	if noOfRecords < 10 || noOfRecords > 500 {
+		return nil, statusError("no-of-records should be between 10 and 500")
+	}
+
+	pageNo, _ := strconv.Atoi(params.Get("page-no"))
+	if pageNo < 1 {
+		return nil, statusError("page-no should be greater than 0")
+	}
+
+	var orders []*Order
+	for _, o := range s.orders {
+		if matches(params["order-id"], itoa(o.ID)) &&
+			matches(params["customer-id"], itoa(o.CustomerID)) &&
+			matches(params["status"], o.Status) &&
+			matches(params["product-key"], o.ProductKey) &&
+			(len(params.Get("domain-name")) == 0 || strings.EqualFold(params.Get("domain-name"), o.DomainName)) {
+			orders = append(orders, o)
+		}
+	}
+
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].ID < orders[j].ID
+	})
+
+	total := len(orders)
+	start := (pageNo - 1) * noOfRecords
+	if start > len(orders) {
+		start = len(orders)
+	}
+	end := start + noOfRecords
+	if end > len(orders) {
+		end = len(orders)
+	}
+	orders = orders[start:end]
+
+	res := map[string]interface{}{
+		"recsonpage": strconv.Itoa(len(orders)),
+		"recsindb":   strconv.Itoa(total),
+	}
+	for i, o := range orders {
+		res[strconv.Itoa(i+1)] = map[string]string{
+			"orders.orderid":            itoa(o.ID),
+			"entity.customerid":         itoa(o.CustomerID),
+			"entity.entityid":           itoa(o.ID),
+			"orders.autorenew":          strconv.FormatBool(o.AutoRenew),
+			"orders.endtime":            itoa(o.EndTime.Unix()),
+			"orders.resellerlock":       "false",
+			"orders.timestamp":          o.CreationTime.UTC().Format("2006-01-02 15:04:05.000000-07"),
+			"orders.customerlock":       "false",
+			"entity.entitytypeid":       "3",
+			"entity.currentstatus":      o.Status,
+			"entitytype.entitytypekey":  o.ProductKey,
+			"orders.transferlock":       "false",
+			"orders.creationtime":       itoa(o.CreationTime.Unix()),
+			"orders.privacyprotection":  strconv.FormatBool(o.PrivacyProtected),
+			"entitytype.entitytypename": "Domain Registration",
+			"orders.creationdt":         itoa(o.CreationTime.Unix()),
+			"entity.description":        o.DomainName,
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Server) domainsRegister(params url.Values) (interface{}, error) {
+	domainName := strings.ToLower(params.Get("domain-name"))
+	if len(domainName) == 0 || !strings.Contains(domainName, ".") {
+		return nil, errorValue("Invalid domain-name")
+	}
+
+	if status := s.domainStatus(domainName); status != "available" {
+		return nil, errorValue(domainName + " is not available for registration")
+	}
+
+	years, err := parseYears(params.Get("years"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params["ns"]) == 0 {
+		return nil, errorValue("At least one Name Server is required")
+	}
+
+	customer, err := s.customer(params.Get("customer-id"))
+	if err != nil {
+		return nil, err
+	}
+
+	contactIDs, err := s.contactIDs(params, customer.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkInvoiceOption(params.Get("invoice-option")); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Truncate(time.Second)
+	o := &Order{
+		ID:               s.newID(),
+		CustomerID:       customer.ID,
+		DomainName:       domainName,
+		ProductKey:       productKey(domainName),
+		Status:           "Active",
+		NS:               params["ns"],
+		RegContactID:     contactIDs[0],
+		AdminContactID:   contactIDs[1],
+		TechContactID:    contactIDs[2],
+		BillingContactID: contactIDs[3],
+		CreationTime:     now,
+		EndTime:          now.AddDate(years, 0, 0),
+		AutoRenew:        params.Get("auto-renew") == "true",
+		PrivacyProtected: params.Get("purchase-privacy") == "true" && params.Get("protect-privacy") == "true",
+		DomainSecret:     fmt.Sprintf("Sec%vret!", s.nextID),
+		ExtraAttrs:       extraAttrs(params),
+	}
+	s.orders[o.ID] = o
+
+	res := s.action(o, "AddNewDomain", fmt.Sprintf("Registration of %v for %v years", o.DomainName, years), "Domain registration completed Successfully")
+	if params.Get("purchase-privacy") == "true" {
+		res["privacydetails"] = s.action(o, "AddPrivacyProtection", "Purchase of Privacy Protection for "+o.DomainName, "Privacy Protection purchased Successfully")
+	}
+
+	return res, nil
+}
+
+func (s *Server) domainsTransfer(params url.Values) (interface{}, error) {
+	domainName := strings.ToLower(params.Get("domain-name"))
+	if len(domainName) == 0 || !strings.Contains(domainName, ".") {
+		return nil, errorValue("Invalid domain-name")
+	}
+
+	switch s.domainStatus(domainName) {
+	case resellerclub.DomainStatusRegisteredThroughOthers:
+	case resellerclub.DomainStatusRegisteredThroughUs:
+		return nil, errorValue(domainName + " is already registered through you")
+	default:
+		return nil, errorValue(domainName + " is not registered yet, it cannot be transferred")
+	}
+
+	customer, err := s.customer(params.Get("customer-id"))
+	if err != nil {
+		return nil, err
+	}
+
+	contactIDs, err := s.contactIDs(params, customer.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkInvoiceOption(params.Get("invoice-option")); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Truncate(time.Second)
+	o := &Order{
+		ID:               s.newID(),
+		CustomerID:       customer.ID,
+		DomainName:       domainName,
+		ProductKey:       productKey(domainName),
+		Status:           "InActive",
+		NS:               params["ns"],
+		RegContactID:     contactIDs[0],
+		AdminContactID:   contactIDs[1],
+		TechContactID:    contactIDs[2],
+		BillingContactID: contactIDs[3],
+		CreationTime:     now,
+		EndTime:          now.AddDate(1, 0, 0),
+		AutoRenew:        params.Get("auto-renew") == "true",
+		PrivacyProtected: params.Get("purchase-privacy") == "true" && params.Get("protect-privacy") == "true",
+		DomainSecret:     params.Get("auth-code"),
+		ExtraAttrs:       extraAttrs(params),
+		TransferPending:  true,
+	}
+	s.orders[o.ID] = o
+	delete(s.availability, domainName)
+
+	res := s.action(o, "AddTransferDomain", "Transfer of "+o.DomainName+" from another Registrar", "Transfer waiting for Authorization")
+	res["actionstatus"] = "Pending"
+	if params.Get("purchase-privacy") == "true" {
+		res["privacydetails"] = s.action(o, "AddPrivacyProtection", "Purchase of Privacy Protection for "+o.DomainName, "Privacy Protection purchased Successfully")
+	}
+
+	return res, nil
+}
+
+func (s *Server) domainsValidateTransfer(params url.Values) (interface{}, error) {
+	domainName := params.Get("domain-name")
+	if len(domainName) == 0 || !strings.Contains(domainName, ".") {
+		return nil, errorValue("Invalid domain-name")
+	}
+
+	return s.domainStatus(domainName) == resellerclub.DomainStatusRegisteredThroughOthers, nil
+}
+
+func (s *Server) domainsCancelTransfer(params url.Values) (interface{}, error) {
+	o, err := s.pendingTransfer(params.Get("order-id"))
+	if err != nil {
+		return nil, err
+	}
+
+	o.Status = "Deleted"
+	o.TransferPending = false
+	s.availability[o.DomainName] = resellerclub.DomainStatusRegisteredThroughOthers
+
+	return map[string]string{"result": "Success"}, nil
+}
+
+func (s *Server) domainsResendRFA(params url.Values) (interface{}, error) {
+	if _, err := s.pendingTransfer(params.Get("order-id")); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+func (s *Server) domainsSubmitAuthCode(params url.Values) (interface{}, error) {
+	o, err := s.pendingTransfer(params.Get("order-id"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params.Get("auth-code")) == 0 {
+		return nil, errorValue("auth-code is required")
+	}
+
+	o.DomainSecret = params.Get("auth-code")
+
+	res := s.action(o, "AddTransferDomain", "Transfer of "+o.DomainName+" from another Registrar", "Authorization Code submitted Successfully")
+	res["actionstatus"] = "Pending"
+
+	return res, nil
+}
+
+func (s *Server) domainsModifyNS(params url.Values) (interface{}, error) {
+	o, err := s.order(params.Get("order-id"))
+	if err != nil {
+		return nil, err
+	}
+
+	ns := params["ns"]
+	if len(ns) < 2 || len(ns) > 13 {
+		return nil, errorValue("Between 2 and 13 Name Servers are required")
+	}
+
+	if strings.EqualFold(strings.Join(ns, ","), strings.Join(o.NS, ",")) {
+		return nil, errorValue("Same value for new and old NameServers")
+	}
+
+	o.NS = ns
+
+	return s.action(o, "ModNS", "Modification of Nameservers of "+o.DomainName+" to "+strings.Join(ns, ", "), "Nameservers modified Successfully"), nil
+}
+
+func (s *Server) domainsAddCNS(params url.Values) (interface{}, error) {
+	o, err := s.order(params.Get("order-id"))
+	if err != nil {
+		return nil, err
+	}
+
+	host := strings.ToLower(params.Get("cns"))
+	if !strings.HasSuffix(host, "."+o.DomainName) {
+		return nil, errorValue("Child Name Server " + host + " must be under " + o.DomainName)
+	}
+	if _, ok := o.CNS[host]; ok {
+		return nil, errorValue("Child Name Server " + host + " already exists")
+	}
+	if len(params["ip"]) == 0 {
+		return nil, errorValue("ip is required")
+	}
+
+	if o.CNS == nil {
+		o.CNS = map[string][]string{}
+	}
+	o.CNS[host] = params["ip"]
+
+	return s.action(o, "AddCNS", "Addition of Child Nameserver "+host+" with IP "+strings.Join(params["ip"], ", "), "Child Nameserver added Successfully"), nil
+}
+
+func (s *Server) domainsModifyCNSName(params url.Values) (interface{}, error) {
+	o, ips, err := s.cns(params.Get("order-id"), params.Get("old-cns"))
+	if err != nil {
+		return nil, err
+	}
+
+	oldHost, newHost := strings.ToLower(params.Get("old-cns")), strings.ToLower(params.Get("new-cns"))
+	if !strings.HasSuffix(newHost, "."+o.DomainName) {
+		return nil, errorValue("Child Name Server " + newHost + " must be under " + o.DomainName)
+	}
+	if _, ok := o.CNS[newHost]; ok {
+		return nil, errorValue("Child Name Server " + newHost + " already exists")
+	}
+
+	delete(o.CNS, oldHost)
+	o.CNS[newHost] = ips
+
+	return s.action(o, "ModCNSName", "Modification of Child Nameserver "+oldHost+" to "+newHost, "Child Nameserver modified Successfully"), nil
+}
+
+func (s *Server) domainsModifyCNSIP(params url.Values) (interface{}, error) {
+	o, ips, err := s.cns(params.Get("order-id"), params.Get("cns"))
+	if err != nil {
+		return nil, err
+	}
+
+	host, oldIP, newIP := strings.ToLower(params.Get("cns")), params.Get("old-ip"), params.Get("new-ip")
+	i := indexOf(ips, oldIP)
+	if i < 0 {
+		return nil, errorValue("IP " + oldIP + " is not associated with " + host)
+	}
+
+	o.CNS[host] = append(append(append([]string{}, ips[:i]...), newIP), ips[i+1:]...)
+
+	return s.action(o, "ModCNSIP", "Modification of IP of Child Nameserver "+host+" from "+oldIP+" to "+newIP, "Child Nameserver modified Successfully"), nil
+}
+
+func (s *Server) domainsDeleteCNSIP(params url.Values) (interface{}, error) {
+	o, ips, err := s.cns(params.Get("order-id"), params.Get("cns"))
+	if err != nil {
+		return nil, err
+	}
+
+	host := strings.ToLower(params.Get("cns"))
+	for _, ip := range params["ip"] {
+		i := indexOf(ips, ip)
+		if i < 0 {
+			return nil, errorValue("IP " + ip + " is not associated with " + host)
+		}
+		ips = append(append([]string{}, ips[:i]...), ips[i+1:]...)
+	}
+
+	if len(ips) == 0 {
+		delete(o.CNS, host)
+	} else {
+		o.CNS[host] = ips
+	}
+
+	return s.action(o, "DelCNSIP", "Deletion of IP "+strings.Join(params["ip"], ", ")+" of Child Nameserver "+host, "Child Nameserver modified Successfully"), nil
+}
+
+func (s *Server) domainsModifyContact(params url.Values) (interface{}, error) {
+	o, err := s.order(params.Get("order-id"))
+	if err != nil {
+		return nil, err
+	}
+
+	if o.PendingRegContactID != 0 {
+		return nil, errorValue("There is already a pending change of registrant for Order ID: " + itoa(o.ID))
+	}
+
+	contactIDs, err := s.contactIDs(params, o.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if contactIDs == [4]int64{o.RegContactID, o.AdminContactID, o.TechContactID, o.BillingContactID} {
+		return nil, errorValue("Same value for new and old Contact Details")
+	}
+
+	o.AdminContactID, o.TechContactID, o.BillingContactID = contactIDs[1], contactIDs[2], contactIDs[3]
+
+	// A change of registrant waits for the confirmation of both registrants, unless the reseller acts as Designated Agent.
+	if contactIDs[0] != o.RegContactID && params.Get("designated-agent") != "true" {
+		o.PendingRegContactID = contactIDs[0]
+
+		res := s.action(o, "ModContact", "Modification of Contact Details of "+o.DomainName, "Waiting for approval of the change of registrant")
+		res["actionstatus"] = "PendingExecution"
+		return res, nil
+	}
+
+	o.RegContactID = contactIDs[0]
+
+	return s.action(o, "ModContact", "Modification of Contact Details of "+o.DomainName, "Contact Details modified Successfully"), nil
+}
+
+func (s *Server) domainsRenew(params url.Values) (interface{}, error) {
+	o, err := s.order(params.Get("order-id"))
+	if err != nil {
+		return nil, err
+	}
+
+	years, err := parseYears(params.Get("years"))
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Get("exp-date") != itoa(o.EndTime.Unix()) {
+		return nil, errorValue("Invalid exp-date. Current expiry date of the order is " + itoa(o.EndTime.Unix()))
+	}
+
+	if err = checkInvoiceOption(params.Get("invoice-option")); err != nil {
+		return nil, err
+	}
+
+	o.EndTime = o.EndTime.AddDate(years, 0, 0)
+	o.AutoRenew = params.Get("auto-renew") == "true"
+
+	res := s.action(o, "RenewDomain", fmt.Sprintf("Renewal of %v for %v years", o.DomainName, years), "Domain renewed Successfully")
+	if params.Get("purchase-privacy") == "true" {
+		res["privacydetails"] = s.action(o, "RenewPrivacyProtection", "Renewal of Privacy Protection for "+o.DomainName, "Privacy Protection renewed Successfully")
+	}
+
+	return res, nil
+}
+
+// action returns the description of an executed action on o, as answered by write calls.
+func (s *Server) action(o *Order, actionType string, desc string, statusDesc string) map[string]interface{} {
+	return map[string]interface{}{
+		"description":             o.DomainName,
+		"entityid":                itoa(o.ID),
+		"actiontype":              actionType,
+		"actiontypedesc":          desc,
+		"eaqid":                   itoa(s.newID()),
+		"actionstatus":            "Success",
+		"actionstatusdesc":        statusDesc,
+		"invoiceid":               itoa(s.newID()),
+		"sellingcurrencysymbol":   "USD",
+		"sellingamount":           "-10.00",
+		"unutilisedsellingamount": "-10.00",
+		"customerid":              itoa(o.CustomerID),
+	}
+}
+
+func (s *Server) order(rawID string) (*Order, error) {
+	id, _ := strconv.ParseInt(rawID, 10, 64)
+
+	o, ok := s.orders[id]
+	if !ok {
+		return nil, statusError("Invalid Order ID: " + rawID)
+	}
+
+	return o, nil
+}
+
+// pendingTransfer returns the order with the given ID, if it is a Transfer waiting for authorization.
+func (s *Server) pendingTransfer(rawID string) (*Order, error) {
+	o, err := s.order(rawID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !o.TransferPending {
+		return nil, errorValue("There is no pending Transfer for Order ID: " + rawID)
+	}
+
+	return o, nil
+}
+
+// cns returns the order with the given ID and the IP addresses of one of its Child Name Servers.
+func (s *Server) cns(rawID string, host string) (*Order, []string, error) {
+	o, err := s.order(rawID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ips, ok := o.CNS[strings.ToLower(host)]
+	if !ok {
+		return nil, nil, errorValue("Child Name Server " + host + " does not exist")
+	}
+
+	return o, ips, nil
+}
+
+func (s *Server) customer(rawID string) (*Customer, error) {
+	id, _ := strconv.ParseInt(rawID, 10, 64)
+
+	c, ok := s.customers[id]
+	if !ok {
+		return nil, errorValue("Invalid customer-id: " + rawID)
+	}
+
+	return c, nil
+}
+
+// contactID validates a contact ID of a customer. Non registrant contacts may be -1.
+func (s *Server) contactID(rawID string, customerID int64, optional bool) (int64, error) {
+	id, _ := strconv.ParseInt(rawID, 10, 64)
+	if id == -1 && optional {
+		return id, nil
+	}
+
+	c, ok := s.contacts[id]
+	if !ok || c.CustomerID != customerID {
+		return 0, errorValue("Invalid contact-id: " + rawID)
+	}
+
+	return id, nil
+}
+
+// contactIDs validates the registrant, admin, tech and billing contacts of a write call.
+func (s *Server) contactIDs(params url.Values, customerID int64) ([4]int64, error) {
+	var ids [4]int64
+	for i, name := range []string{"reg-contact-id", "admin-contact-id", "tech-contact-id", "billing-contact-id"} {
+		id, err := s.contactID(params.Get(name), customerID, i > 0)
+		if err != nil {
+			return ids, err
+		}
+		ids[i] = id
+	}
+
+	return ids, nil
+}
+
+// extraAttrs returns the attr-nameN/attr-valueN pairs of a write call.
+func extraAttrs(params url.Values) map[string]string {
+	attrs := map[string]string{}
+	for i := 1; len(params.Get(fmt.Sprintf("attr-name%v", i))) > 0; i++ {
+		attrs[params.Get(fmt.Sprintf("attr-name%v", i))] = params.Get(fmt.Sprintf("attr-value%v", i))
+	}
+
+	return attrs
+}
+
+func contactJSON(c *Contact) map[string]interface{} {
+	return map[string]interface{}{
+		"contactid":     itoa(c.ID),
+		"customerid":    itoa(c.CustomerID),
+		"type":          c.Type,
+		"contacttype":   []string{},
+		"name":          c.Name,
+		"company":       c.Company,
+		"emailaddr":     c.Email,
+		"address1":      c.Address1,
+		"city":          c.City,
+		"state":         c.State,
+		"country":       c.Country,
+		"zip":           c.Zip,
+		"telnocc":       c.TelNoCC,
+		"telno":         c.TelNo,
+		"contactstatus": "Active",
+	}
+}
+
+func parseYears(raw string) (int, error) {
+	years, err := strconv.Atoi(raw)
+	if err != nil || years < 1 || years > 10 {
+		return 0, errorValue("Invalid years: " + raw)
+	}
+
+	return years, nil
+}
+
+func checkInvoiceOption(option string) error {
+	switch option {
+	case "NoInvoice", "PayInvoice", "KeepInvoice", "OnlyAdd":
+		return nil
+	}
+
+	return errorValue("Invalid invoice-option: " + option)
+}
+
+// matches tells whether value is one of filter, or filter is empty.
+func matches(filter []string, value string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+
+	for _, f := range filter {
+		if strings.EqualFold(f, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func itoa(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
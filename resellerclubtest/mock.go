@@ -0,0 +1,340 @@
+package resellerclubtest
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/saulortega/resellerclub"
+)
+
+// ErrNotScripted is returned by the mocks for the methods without a scripted response.
+var ErrNotScripted = errors.New("resellerclubtest: call not scripted")
+
+// Call is a call recorded by a mock.
+type Call struct {
+	Method string        // Name of the method, without the Context suffix
+	Args   []interface{} // Arguments of the call, without the context
+}
+
+// callLog records the calls of a mock.
+type callLog struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (l *callLog) record(method string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.calls = append(l.calls, Call{method, args})
+}
+
+// Calls returns the recorded calls, in order.
+func (l *callLog) Calls() []Call {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]Call(nil), l.calls...)
+}
+
+// CallsTo returns the recorded calls to a method, in order.
+func (l *callLog) CallsTo(method string) []Call {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var calls []Call
+	for _, c := range l.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+
+	return calls
+}
+
+// Reset forgets the recorded calls.
+func (l *callLog) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.calls = nil
+}
+
+// MockDomains is an in-memory resellerclub.DomainsService for unit tests.
+// The Func fields script the response of each method; methods whose field is nil return ErrNotScripted.
+// Every call is recorded, whether it was made with the Context variant or not.
+//
+//	client := resellerclub.New("", "")
+//	client.Domains = &resellerclubtest.MockDomains{
+//		GetOrderIDFunc: func(ctx context.Context, domainName string) (int64, error) {
+//			return 123, nil
+//		},
+//	}
+type MockDomains struct {
+	callLog
+
+	CheckAvailabilityFunc          func(ctx context.Context, domainNames []string, tlds []string) ([]*resellerclub.DomainAvailabilityResponse, error)
+	GetOrderIDFunc                 func(ctx context.Context, domainName string) (int64, error)
+	GetOrderDetailsFunc            func(ctx context.Context, orderID int64, options ...resellerclub.OrderDetailsOption) (*resellerclub.DomainGetOrderDetailsResponse, error)
+	SearchFunc                     func(ctx context.Context, params *resellerclub.DomainSearchParams) ([]*resellerclub.DomainSearchResponseItem, error)
+	RegisterFunc                   func(ctx context.Context, params *resellerclub.DomainRegisterParams) (*resellerclub.DomainRegisterResponse, error)
+	RenewFunc                      func(ctx context.Context, params *resellerclub.DomainRenewParams) (*resellerclub.DomainRenewResponse, error)
+	TransferFunc                   func(ctx context.Context, params *resellerclub.DomainTransferParams) (*resellerclub.DomainTransferResponse, error)
+	ValidateTransferFunc           func(ctx context.Context, domainName string) (bool, error)
+	CancelTransferFunc             func(ctx context.Context, orderID int64) (*resellerclub.DomainCancelTransferResponse, error)
+	ResendTransferApprovalMailFunc func(ctx context.Context, orderID int64) (bool, error)
+	SubmitAuthCodeFunc             func(ctx context.Context, orderID int64, authCode string) (*resellerclub.DomainSubmitAuthCodeResponse, error)
+	ModifyNameServersFunc          func(ctx context.Context, orderID int64, ns []string) (*resellerclub.DomainModifyNameServersResponse, error)
+	AddChildNameServerFunc         func(ctx context.Context, orderID int64, host string, ips []string) (*resellerclub.DomainChildNameServerResponse, error)
+	ModifyChildNameServerHostFunc  func(ctx context.Context, orderID int64, oldHost string, newHost string) (*resellerclub.DomainChildNameServerResponse, error)
+	ModifyChildNameServerIPFunc    func(ctx context.Context, orderID int64, host string, oldIP string, newIP string) (*resellerclub.DomainChildNameServerResponse, error)
+	DeleteChildNameServerIPFunc    func(ctx context.Context, orderID int64, host string, ips []string) (*resellerclub.DomainChildNameServerResponse, error)
+	ModifyContactsFunc             func(ctx context.Context, orderID int64, regContactID int64, adminContactID int64, techContactID int64, billingContactID int64, options ...resellerclub.ModifyContactsOption) (*resellerclub.DomainModifyContactsResponse, error)
+}
+
+var _ resellerclub.DomainsService = (*MockDomains)(nil)
+
+func (m *MockDomains) CheckAvailability(domainNames []string, tlds []string) ([]*resellerclub.DomainAvailabilityResponse, error) {
+	return m.CheckAvailabilityContext(context.Background(), domainNames, tlds)
+}
+
+func (m *MockDomains) CheckAvailabilityContext(ctx context.Context, domainNames []string, tlds []string) ([]*resellerclub.DomainAvailabilityResponse, error) {
+	m.record("CheckAvailability", domainNames, tlds)
+	if m.CheckAvailabilityFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.CheckAvailabilityFunc(ctx, domainNames, tlds)
+}
+
+func (m *MockDomains) GetOrderID(domainName string) (int64, error) {
+	return m.GetOrderIDContext(context.Background(), domainName)
+}
+
+func (m *MockDomains) GetOrderIDContext(ctx context.Context, domainName string) (int64, error) {
+	m.record("GetOrderID", domainName)
+	if m.GetOrderIDFunc == nil {
+		return 0, ErrNotScripted
+	}
+
+	return m.GetOrderIDFunc(ctx, domainName)
+}
+
+func (m *MockDomains) GetOrderDetails(orderID int64, options ...resellerclub.OrderDetailsOption) (*resellerclub.DomainGetOrderDetailsResponse, error) {
+	return m.GetOrderDetailsContext(context.Background(), orderID, options...)
+}
+
+func (m *MockDomains) GetOrderDetailsContext(ctx context.Context, orderID int64, options ...resellerclub.OrderDetailsOption) (*resellerclub.DomainGetOrderDetailsResponse, error) {
+	m.record("GetOrderDetails", orderID, options)
+	if m.GetOrderDetailsFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.GetOrderDetailsFunc(ctx, orderID, options...)
+}
+
+func (m *MockDomains) Search(params *resellerclub.DomainSearchParams) ([]*resellerclub.DomainSearchResponseItem, error) {
+	return m.SearchContext(context.Background(), params)
+}
+
+func (m *MockDomains) SearchContext(ctx context.Context, params *resellerclub.DomainSearchParams) ([]*resellerclub.DomainSearchResponseItem, error) {
+	m.record("Search", params)
+	if m.SearchFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.SearchFunc(ctx, params)
+}
+
+func (m *MockDomains) Register(params *resellerclub.DomainRegisterParams) (*resellerclub.DomainRegisterResponse, error) {
+	return m.RegisterContext(context.Background(), params)
+}
+
+func (m *MockDomains) RegisterContext(ctx context.Context, params *resellerclub.DomainRegisterParams) (*resellerclub.DomainRegisterResponse, error) {
+	m.record("Register", params)
+	if m.RegisterFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.RegisterFunc(ctx, params)
+}
+
+func (m *MockDomains) Renew(params *resellerclub.DomainRenewParams) (*resellerclub.DomainRenewResponse, error) {
+	return m.RenewContext(context.Background(), params)
+}
+
+func (m *MockDomains) RenewContext(ctx context.Context, params *resellerclub.DomainRenewParams) (*resellerclub.DomainRenewResponse, error) {
+	m.record("Renew", params)
+	if m.RenewFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.RenewFunc(ctx, params)
+}
+
+func (m *MockDomains) Transfer(params *resellerclub.DomainTransferParams) (*resellerclub.DomainTransferResponse, error) {
+	return m.TransferContext(context.Background(), params)
+}
+
+func (m *MockDomains) TransferContext(ctx context.Context, params *resellerclub.DomainTransferParams) (*resellerclub.DomainTransferResponse, error) {
+	m.record("Transfer", params)
+	if m.TransferFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.TransferFunc(ctx, params)
+}
+
+func (m *MockDomains) ValidateTransfer(domainName string) (bool, error) {
+	return m.ValidateTransferContext(context.Background(), domainName)
+}
+
+func (m *MockDomains) ValidateTransferContext(ctx context.Context, domainName string) (bool, error) {
+	m.record("ValidateTransfer", domainName)
+	if m.ValidateTransferFunc == nil {
+		return false, ErrNotScripted
+	}
+
+	return m.ValidateTransferFunc(ctx, domainName)
+}
+
+func (m *MockDomains) CancelTransfer(orderID int64) (*resellerclub.DomainCancelTransferResponse, error) {
+	return m.CancelTransferContext(context.Background(), orderID)
+}
+
+func (m *MockDomains) CancelTransferContext(ctx context.Context, orderID int64) (*resellerclub.DomainCancelTransferResponse, error) {
+	m.record("CancelTransfer", orderID)
+	if m.CancelTransferFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.CancelTransferFunc(ctx, orderID)
+}
+
+func (m *MockDomains) ResendTransferApprovalMail(orderID int64) (bool, error) {
+	return m.ResendTransferApprovalMailContext(context.Background(), orderID)
+}
+
+func (m *MockDomains) ResendTransferApprovalMailContext(ctx context.Context, orderID int64) (bool, error) {
+	m.record("ResendTransferApprovalMail", orderID)
+	if m.ResendTransferApprovalMailFunc == nil {
+		return false, ErrNotScripted
+	}
+
+	return m.ResendTransferApprovalMailFunc(ctx, orderID)
+}
+
+func (m *MockDomains) SubmitAuthCode(orderID int64, authCode string) (*resellerclub.DomainSubmitAuthCodeResponse, error) {
+	return m.SubmitAuthCodeContext(context.Background(), orderID, authCode)
+}
+
+func (m *MockDomains) SubmitAuthCodeContext(ctx context.Context, orderID int64, authCode string) (*resellerclub.DomainSubmitAuthCodeResponse, error) {
+	m.record("SubmitAuthCode", orderID, authCode)
+	if m.SubmitAuthCodeFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.SubmitAuthCodeFunc(ctx, orderID, authCode)
+}
+
+func (m *MockDomains) ModifyNameServers(orderID int64, ns []string) (*resellerclub.DomainModifyNameServersResponse, error) {
+	return m.ModifyNameServersContext(context.Background(), orderID, ns)
+}
+
+func (m *MockDomains) ModifyNameServersContext(ctx context.Context, orderID int64, ns []string) (*resellerclub.DomainModifyNameServersResponse, error) {
+	m.record("ModifyNameServers", orderID, ns)
+	if m.ModifyNameServersFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.ModifyNameServersFunc(ctx, orderID, ns)
+}
+
+func (m *MockDomains) AddChildNameServer(orderID int64, host string, ips []string) (*resellerclub.DomainChildNameServerResponse, error) {
+	return m.AddChildNameServerContext(context.Background(), orderID, host, ips)
+}
+
+func (m *MockDomains) AddChildNameServerContext(ctx context.Context, orderID int64, host string, ips []string) (*resellerclub.DomainChildNameServerResponse, error) {
+	m.record("AddChildNameServer", orderID, host, ips)
+	if m.AddChildNameServerFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.AddChildNameServerFunc(ctx, orderID, host, ips)
+}
+
+func (m *MockDomains) ModifyChildNameServerHost(orderID int64, oldHost string, newHost string) (*resellerclub.DomainChildNameServerResponse, error) {
+	return m.ModifyChildNameServerHostContext(context.Background(), orderID, oldHost, newHost)
+}
+
+func (m *MockDomains) ModifyChildNameServerHostContext(ctx context.Context, orderID int64, oldHost string, newHost string) (*resellerclub.DomainChildNameServerResponse, error) {
+	m.record("ModifyChildNameServerHost", orderID, oldHost, newHost)
+	if m.ModifyChildNameServerHostFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.ModifyChildNameServerHostFunc(ctx, orderID, oldHost, newHost)
+}
+
+func (m *MockDomains) ModifyChildNameServerIP(orderID int64, host string, oldIP string, newIP string) (*resellerclub.DomainChildNameServerResponse, error) {
+	return m.ModifyChildNameServerIPContext(context.Background(), orderID, host, oldIP, newIP)
+}
+
+func (m *MockDomains) ModifyChildNameServerIPContext(ctx context.Context, orderID int64, host string, oldIP string, newIP string) (*resellerclub.DomainChildNameServerResponse, error) {
+	m.record("ModifyChildNameServerIP", orderID, host, oldIP, newIP)
+	if m.ModifyChildNameServerIPFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.ModifyChildNameServerIPFunc(ctx, orderID, host, oldIP, newIP)
+}
+
+func (m *MockDomains) DeleteChildNameServerIP(orderID int64, host string, ips []string) (*resellerclub.DomainChildNameServerResponse, error) {
+	return m.DeleteChildNameServerIPContext(context.Background(), orderID, host, ips)
+}
+
+func (m *MockDomains) DeleteChildNameServerIPContext(ctx context.Context, orderID int64, host string, ips []string) (*resellerclub.DomainChildNameServerResponse, error) {
+	m.record("DeleteChildNameServerIP", orderID, host, ips)
+	if m.DeleteChildNameServerIPFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.DeleteChildNameServerIPFunc(ctx, orderID, host, ips)
+}
+
+func (m *MockDomains) ModifyContacts(orderID int64, regContactID int64, adminContactID int64, techContactID int64, billingContactID int64, options ...resellerclub.ModifyContactsOption) (*resellerclub.DomainModifyContactsResponse, error) {
+	return m.ModifyContactsContext(context.Background(), orderID, regContactID, adminContactID, techContactID, billingContactID, options...)
+}
+
+func (m *MockDomains) ModifyContactsContext(ctx context.Context, orderID int64, regContactID int64, adminContactID int64, techContactID int64, billingContactID int64, options ...resellerclub.ModifyContactsOption) (*resellerclub.DomainModifyContactsResponse, error) {
+	m.record("ModifyContacts", orderID, regContactID, adminContactID, techContactID, billingContactID, options)
+	if m.ModifyContactsFunc == nil {
+		return nil, ErrNotScripted
+	}
+
+	return m.ModifyContactsFunc(ctx, orderID, regContactID, adminContactID, techContactID, billingContactID, options...)
+}
+
+// MockCustomers is an in-memory resellerclub.CustomersService for unit tests.
+// It works like MockDomains.
+type MockCustomers struct {
+	callLog
+
+	CreateFunc func(ctx context.Context, params *resellerclub.CustomerCreateParams) (int64, error)
+}
+
+var _ resellerclub.CustomersService = (*MockCustomers)(nil)
+
+func (m *MockCustomers) Create(params *resellerclub.CustomerCreateParams) (int64, error) {
+	return m.CreateContext(context.Background(), params)
+}
+
+func (m *MockCustomers) CreateContext(ctx context.Context, params *resellerclub.CustomerCreateParams) (int64, error) {
+	m.record("Create", params)
+	if m.CreateFunc == nil {
+		return 0, ErrNotScripted
+	}
+
+	return m.CreateFunc(ctx, params)
+}
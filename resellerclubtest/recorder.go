@@ -0,0 +1,368 @@
+package resellerclubtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RecorderMode defines whether a Recorder records or replays the API calls.
+type RecorderMode int
+
+const (
+	// RecorderModeReplay answers the calls with the interactions of the cassette file.
+	RecorderModeReplay RecorderMode = iota
+
+	// RecorderModeRecord sends the calls to the API and records them, to be saved with Save.
+	RecorderModeRecord
+
+	// RecorderModeAuto replays the cassette file if it exists, and records it otherwise.
+	RecorderModeAuto
+)
+
+// redactedValue replaces the values of the redacted parameters in the cassettes.
+const redactedValue = "REDACTED"
+
+// defaultRedactedParams are the parameters always redacted from the cassettes.
+var defaultRedactedParams = []string{"auth-userid", "api-key", "passwd", "auth-code"}
+
+// defaultRedactedFields are the fields of the JSON responses always redacted from the cassettes.
+var defaultRedactedFields = []string{"domsecret"}
+
+var attrNameRegexp = regexp.MustCompile(`^attr-name(\d+)$`)
+
+// Recorder is an http.RoundTripper that records API interactions to a cassette file
+// and replays them in tests. It is safe for concurrent use.
+//
+// The credentials (auth-userid, api-key and passwd), the auth codes and the parameters in RedactParams are
+// redacted from the cassettes, in the requests and in the response bodies, and ignored when
+// matching. The values of the domsecret field and of the fields in RedactFields are redacted
+// from the JSON response bodies. Calls are matched by method, path and parameters, whatever their order, and
+// attr-nameN/attr-valueN pairs are matched whatever their numbering.
+//
+//	rec, err := resellerclubtest.NewRecorder("testdata/register.json", resellerclubtest.RecorderModeAuto)
+//	...
+//	defer rec.Save()
+//	client := resellerclub.New(userID, apiKey, resellerclub.WithHTTPClient(rec.Client()))
+type Recorder struct {
+	// Transport used to send the calls in record mode. http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	// Additional parameters whose values are redacted.
+	RedactParams []string
+
+	// Additional fields of the JSON responses whose values are redacted, at any depth.
+	RedactFields []string
+
+	path         string
+	mode         RecorderMode
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         map[*Interaction]bool
+}
+
+// Interaction is a recorded API call.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the request of an Interaction.
+type RecordedRequest struct {
+	Method string     `json:"method"`
+	Path   string     `json:"path"`
+	Params url.Values `json:"params"`
+}
+
+// RecordedResponse is the response of an Interaction.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+}
+
+type cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// NewRecorder returns a Recorder for the cassette file at path.
+// In replay mode, and in auto mode when the file exists, the cassette is loaded.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{
+		path: path,
+		mode: mode,
+		used: map[*Interaction]bool{},
+	}
+
+	if mode == RecorderModeAuto {
+		r.mode = RecorderModeRecord
+		if _, err := os.Stat(path); err == nil {
+			r.mode = RecorderModeReplay
+		}
+	}
+
+	if r.mode == RecorderModeReplay {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var c cassette
+		if err = json.Unmarshal(data, &c); err != nil {
+			return nil, fmt.Errorf("resellerclubtest: invalid cassette %v: %v", path, err)
+		}
+
+		r.interactions = c.Interactions
+	}
+
+	return r, nil
+}
+
+// Client returns an HTTP client using the Recorder, to be used with resellerclub.WithHTTPClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Save writes the recorded interactions to the cassette file. It does nothing in replay mode.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode != RecorderModeRecord {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(cassette{r.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(r.path, data, 0644)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, body, err := cloneRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := requestParams(req, body)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := RecordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Params: params,
+	}
+	secrets := r.redact(recorded.Params)
+
+	if r.mode == RecorderModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	return r.record(req, recorded, secrets)
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := matchKey(recorded)
+
+	var match *Interaction
+	for _, i := range r.interactions {
+		if matchKey(i.Request) != key {
+			continue
+		}
+
+		match = i
+		if !r.used[i] {
+			break
+		}
+	}
+
+	if match == nil {
+		return nil, fmt.Errorf("resellerclubtest: no recorded interaction for %v %v?%v", recorded.Method, recorded.Path, recorded.Params.Encode())
+	}
+
+	r.used[match] = true
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", match.Response.StatusCode, http.StatusText(match.Response.StatusCode)),
+		StatusCode:    match.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        match.Response.Header.Clone(),
+		Body:          ioutil.NopCloser(strings.NewReader(match.Response.Body)),
+		ContentLength: int64(len(match.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) record(req *http.Request, recorded RecordedRequest, secrets []string) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+
+	redactedBody := string(body)
+	for _, secret := range secrets {
+		redactedBody = redactString(redactedBody, secret)
+	}
+	redactedBody = r.redactFields(redactedBody)
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, &Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       redactedBody,
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// redact replaces the values of the redacted parameters and returns the original values.
+func (r *Recorder) redact(params url.Values) []string {
+	var secrets []string
+	for _, name := range append(defaultRedactedParams, r.RedactParams...) {
+		for _, v := range params[name] {
+			if len(v) > 0 {
+				secrets = append(secrets, v)
+			}
+		}
+
+		if _, ok := params[name]; ok {
+			params.Set(name, redactedValue)
+		}
+	}
+
+	return secrets
+}
+
+// redactFields replaces the string values of the redacted fields of a JSON body.
+func (r *Recorder) redactFields(body string) string {
+	var names []string
+	for _, name := range append(defaultRedactedFields, r.RedactFields...) {
+		names = append(names, regexp.QuoteMeta(name))
+	}
+
+	re := regexp.MustCompile(`("(?:` + strings.Join(names, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	return re.ReplaceAllString(body, `${1}"`+redactedValue+`"`)
+}
+
+// redactString replaces secret in s. Numeric secrets, like reseller IDs, are only replaced
+// when they are not part of a longer number.
+func redactString(s string, secret string) string {
+	for _, c := range secret {
+		if c < '0' || c > '9' {
+			return strings.Replace(s, secret, redactedValue, -1)
+		}
+	}
+
+	re := regexp.MustCompile(`(^|[^0-9])` + secret + `([^0-9]|$)`)
+	return re.ReplaceAllString(s, "${1}"+redactedValue+"${2}")
+}
+
+// requestParams returns the parameters of req, from the query string and the form-encoded body.
+func requestParams(req *http.Request, body []byte) (url.Values, error) {
+	params := req.URL.Query()
+
+	if len(body) == 0 || !strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		return params, nil
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, errors.New("resellerclubtest: invalid form body: " + err.Error())
+	}
+
+	for k, v := range form {
+		params[k] = append(params[k], v...)
+	}
+
+	return params, nil
+}
+
+// cloneRequest reads the body of req and returns a copy of req with a fresh body, along with the body.
+// A RoundTripper must not modify the request it is given, so only the copy is parsed and forwarded.
+func cloneRequest(req *http.Request) (*http.Request, []byte, error) {
+	clone := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return clone, nil, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clone.Body = ioutil.NopCloser(bytes.NewReader(body))
+	clone.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return clone, body, nil
+}
+
+// matchKey returns a canonical form of a request, ignoring the order of the parameters and of
+// their values, the numbering of attr-nameN/attr-valueN pairs and the values of redacted parameters.
+func matchKey(req RecordedRequest) string {
+	var parts []string
+	for k, values := range req.Params {
+		if m := attrNameRegexp.FindStringSubmatch(k); m != nil {
+			for _, v := range values {
+				parts = append(parts, "attr:"+v+"="+strings.Join(req.Params["attr-value"+m[1]], ","))
+			}
+			continue
+		}
+
+		if strings.HasPrefix(k, "attr-value") {
+			continue
+		}
+
+		for _, v := range values {
+			if v == redactedValue {
+				continue
+			}
+			parts = append(parts, k+"="+v)
+		}
+	}
+
+	sort.Strings(parts)
+
+	return req.Method + " " + req.Path + "?" + strings.Join(parts, "&")
+}
@@ -0,0 +1,144 @@
+package resellerclubtest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/saulortega/resellerclub"
+)
+
+func TestRecorderRecordThenReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	s := NewServer()
+	customerID := s.AddCustomer(Customer{Username: "jane@example.com"})
+	contactID := s.AddContact(Contact{CustomerID: customerID})
+	orderID := s.AddOrder(Order{DomainName: "example.com", CustomerID: customerID, DomainSecret: "Tr@nsfer-Secret"})
+
+	params := &resellerclub.DomainRegisterParams{
+		DomainName:       "example.org",
+		Years:            1,
+		NS:               []string{"ns1.example.com", "ns2.example.com"},
+		CustomerID:       customerID,
+		RegContactID:     contactID,
+		AdminContactID:   contactID,
+		TechContactID:    contactID,
+		BillingContactID: contactID,
+		InvoiceOption:    "NoInvoice",
+		ExtraAttrs:       map[string]string{"a": "1", "b": "2", "c": "3", "d": "4", "e": "5", "f": "6"},
+	}
+
+	rec, err := NewRecorder(path, RecorderModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec.Transport = s.server.Client().Transport
+	client := resellerclub.New(s.UserID, s.APIKey, resellerclub.WithEndpoint(s.URL), resellerclub.WithHTTPClient(rec.Client()), resellerclub.WithoutRetries())
+
+	registered, err := client.Domains.Register(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Domains.GetOrderDetails(orderID, resellerclub.OrderDetailsOptionAll); err != nil {
+		t.Fatal(err)
+	}
+	if err = rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{s.APIKey, "Tr@nsfer-Secret"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette holds %q", secret)
+		}
+	}
+
+	// The server is closed: the calls can only be answered by the cassette.
+	rec, err = NewRecorder(path, RecorderModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = resellerclub.New("654321", "another-api-key", resellerclub.WithEndpoint(s.URL), resellerclub.WithHTTPClient(rec.Client()), resellerclub.WithoutRetries())
+
+	// ExtraAttrs is a map, so every call numbers the attr-nameN pairs in another order.
+	for i := 0; i < 10; i++ {
+		res, err := client.Domains.Register(params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.EntityID != registered.EntityID {
+			t.Errorf("got order %v, want %v", res.EntityID, registered.EntityID)
+		}
+	}
+
+	details, err := client.Domains.GetOrderDetails(orderID, resellerclub.OrderDetailsOptionAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.DomainSecret != "REDACTED" {
+		t.Errorf("got domsecret %q, want REDACTED", details.DomainSecret)
+	}
+
+	params.DomainName = "example.net"
+	if _, err = client.Domains.Register(params); err == nil {
+		t.Error("got no error for a call that was not recorded")
+	}
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRecorderLeavesTheRequest(t *testing.T) {
+	rec, err := NewRecorder(filepath.Join(t.TempDir(), "cassette.json"), RecorderModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "https://httpapi.com/api/domains/renew.json?order-id=1", strings.NewReader("years=1&api-key=secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	body := req.Body
+
+	var forwarded string
+	rec.Transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r == req {
+			t.Error("the request of the caller was forwarded")
+		}
+
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		forwarded = string(data)
+
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader(`{}`)), Request: r}, nil
+	})
+
+	if _, err = rec.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+
+	if req.Body != body {
+		t.Error("the body of the request of the caller was replaced")
+	}
+	if forwarded != "years=1&api-key=secret" {
+		t.Errorf("got forwarded body %q", forwarded)
+	}
+
+	recorded := rec.interactions[0].Request.Params
+	if recorded.Get("order-id") != "1" || recorded.Get("years") != "1" || recorded.Get("api-key") != redactedValue {
+		t.Errorf("got recorded params %v", recorded)
+	}
+}
@@ -0,0 +1,212 @@
+// Package resellerclubtest provides an in-process fake of the ResellerClub HTTP API for tests.
+//
+// The fake keeps state for customers, contacts and domain orders, and answers with the same
+// JSON shapes as the real API: numeric values as strings, search results keyed by row number
+// and errors as status/message or errorvalue objects.
+//
+//	srv := resellerclubtest.NewServer()
+//	defer srv.Close()
+//
+//	customerID := srv.AddCustomer(resellerclubtest.Customer{Username: "me@example.com"})
+//	contactID := srv.AddContact(resellerclubtest.Contact{CustomerID: customerID})
+//
+//	client := srv.Client()
+//	res, err := client.Domains.Register(&resellerclub.DomainRegisterParams{...})
+package resellerclubtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/saulortega/resellerclub"
+)
+
+const (
+	DefaultUserID = "123456"
+	DefaultAPIKey = "resellerclubtest-api-key"
+)
+
+// Server is a fake ResellerClub HTTP API. It is safe for concurrent use.
+type Server struct {
+	// Endpoint of the fake API, to be used with resellerclub.WithEndpoint.
+	URL string
+
+	// Credentials accepted by the fake API.
+	UserID string
+	APIKey string
+
+	server   *httptest.Server
+	handlers map[string]handler
+
+	mu           sync.Mutex
+	nextID       int64
+	customers    map[int64]*Customer
+	contacts     map[int64]*Contact
+	orders       map[int64]*Order
+	availability map[string]string
+	failures     map[string][]failure
+}
+
+// handler answers a call to an API path with a value to be encoded as JSON, or an error.
+type handler func(params url.Values) (interface{}, error)
+
+type failure struct {
+	status int
+	body   string
+}
+
+// statusError is an error answered as {"status":"ERROR","message":"..."}.
+type statusError string
+
+func (e statusError) Error() string {
+	return string(e)
+}
+
+// errorValue is an error answered as {"errorvalue":{"error":"..."}}.
+type errorValue string
+
+func (e errorValue) Error() string {
+	return string(e)
+}
+
+// NewServer starts a fake API accepting DefaultUserID and DefaultAPIKey. It must be closed with Close.
+func NewServer() *Server {
+	s := &Server{
+		UserID:       DefaultUserID,
+		APIKey:       DefaultAPIKey,
+		nextID:       1000,
+		customers:    map[int64]*Customer{},
+		contacts:     map[int64]*Contact{},
+		orders:       map[int64]*Order{},
+		availability: map[string]string{},
+		failures:     map[string][]failure{},
+	}
+
+	s.handlers = map[string]handler{
+		"/domains/available.json":                 s.domainsAvailable,
+		"/domains/orderid.json":                   s.domainsOrderID,
+		"/domains/details.json":                   s.domainsDetails,
+		"/domains/search.json":                    s.domainsSearch,
+		"/domains/register.json":                  s.domainsRegister,
+		"/domains/renew.json":                     s.domainsRenew,
+		"/domains/transfer.json":                  s.domainsTransfer,
+		"/domains/validate-transfer.json":         s.domainsValidateTransfer,
+		"/domains/cancel-transfer.json":           s.domainsCancelTransfer,
+		"/domains/resend-rfa.json":                s.domainsResendRFA,
+		"/domains/transfer/submit-auth-code.json": s.domainsSubmitAuthCode,
+		"/domains/modify-ns.json":                 s.domainsModifyNS,
+		"/domains/add-cns.json":                   s.domainsAddCNS,
+		"/domains/modify-cns-name.json":           s.domainsModifyCNSName,
+		"/domains/modify-cns-ip.json":             s.domainsModifyCNSIP,
+		"/domains/delete-cns-ip.json":             s.domainsDeleteCNSIP,
+		"/domains/modify-contact.json":            s.domainsModifyContact,
+		"/customers/v2/signup.json":               s.customersSignup,
+	}
+
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL + "/api"
+
+	return s
+}
+
+// Close shuts down the fake API.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a Client configured for the fake API, without retries.
+// The given options are applied after those.
+func (s *Server) Client(opts ...resellerclub.Option) *resellerclub.Client {
+	opts = append([]resellerclub.Option{
+		resellerclub.WithEndpoint(s.URL),
+		resellerclub.WithHTTPClient(s.server.Client()),
+		resellerclub.WithoutRetries(),
+	}, opts...)
+
+	return resellerclub.New(s.UserID, s.APIKey, opts...)
+}
+
+// FailNext makes the next call to the API path, e.g. /domains/details.json,
+// answer with the given status code and body. Calls can be queued.
+func (s *Server) FailNext(path string, status int, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures[path] = append(s.failures[path], failure{status, body})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/api")
+
+	if f, ok := s.nextFailure(path); ok {
+		w.WriteHeader(f.status)
+		fmt.Fprint(w, f.body)
+		return
+	}
+
+	h, ok := s.handlers[path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"status": "ERROR", "message": err.Error()})
+		return
+	}
+
+	if r.Form.Get("auth-userid") != s.UserID || r.Form.Get("api-key") != s.APIKey {
+		writeError(w, statusError("Invalid auth-userid or api-key"))
+		return
+	}
+
+	s.mu.Lock()
+	res, err := h(r.Form)
+	s.mu.Unlock()
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) nextFailure(path string) (failure, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue := s.failures[path]
+	if len(queue) == 0 {
+		return failure{}, false
+	}
+
+	s.failures[path] = queue[1:]
+
+	return queue[0], true
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch e := err.(type) {
+	case errorValue:
+		writeJSON(w, http.StatusInternalServerError, map[string]interface{}{
+			"errorvalue": map[string]string{"error": string(e)},
+		})
+	default:
+		writeJSON(w, http.StatusInternalServerError, map[string]string{
+			"status":  "ERROR",
+			"message": err.Error(),
+		})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,150 @@
+package resellerclubtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/saulortega/resellerclub"
+)
+
+func TestRegisterGetOrderDetailsRenew(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client(resellerclub.WithoutRetries())
+
+	customerID := s.AddCustomer(Customer{Username: "jane@example.com"})
+	contactID := s.AddContact(Contact{CustomerID: customerID})
+
+	params := &resellerclub.DomainRegisterParams{
+		DomainName:       "example.com",
+		Years:            1,
+		NS:               []string{"ns1.example.net", "ns2.example.net"},
+		CustomerID:       customerID,
+		RegContactID:     contactID,
+		AdminContactID:   contactID,
+		TechContactID:    contactID,
+		BillingContactID: contactID,
+		InvoiceOption:    "NoInvoice",
+	}
+
+	registered, err := client.Domains.Register(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orderID := int64(registered.EntityID)
+	if orderID == 0 || registered.ActionStatus != resellerclub.ActionStatusSuccess {
+		t.Fatalf("got order %v with ActionStatus %q", orderID, registered.ActionStatus)
+	}
+
+	details, err := client.Domains.GetOrderDetails(orderID, resellerclub.OrderDetailsOptionAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.DomainName != "example.com" || int64(details.OrderID) != orderID || int64(details.CustomerID) != customerID {
+		t.Errorf("got order %v of %v for %v", details.OrderID, details.CustomerID, details.DomainName)
+	}
+	expiry := time.Time(details.EndTime)
+	if o, _ := s.Order(orderID); !expiry.Equal(o.EndTime) {
+		t.Errorf("got expiry %v, want %v", expiry, o.EndTime)
+	}
+
+	if _, err = client.Domains.Renew(&resellerclub.DomainRenewParams{
+		OrderID:       orderID,
+		Years:         2,
+		ExpDate:       expiry,
+		InvoiceOption: "NoInvoice",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	details, err = client.Domains.GetOrderDetails(orderID, resellerclub.OrderDetailsOptionOrderDetails)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := time.Time(details.EndTime), expiry.AddDate(2, 0, 0); !got.Equal(want) {
+		t.Errorf("after Renew: got expiry %v, want %v", got, want)
+	}
+
+	// The errors come in both shapes of the API: {"errorvalue":{"error":...}} and {"status":"ERROR","message":...}.
+	if _, err = client.Domains.Register(params); resellerclub.ErrorCodeOf(err) != resellerclub.ErrorCodeDomainNotAvailable {
+		t.Errorf("registering again: got %v, want a domain_not_available error", err)
+	}
+	if _, err = client.Domains.Renew(&resellerclub.DomainRenewParams{OrderID: orderID, Years: 1, ExpDate: expiry, InvoiceOption: "NoInvoice"}); err == nil || !strings.Contains(err.Error(), "Invalid exp-date") {
+		t.Errorf("renewing with the old expiry: got %v", err)
+	}
+	if _, err = client.Domains.GetOrderDetails(orderID+1000, resellerclub.OrderDetailsOptionAll); resellerclub.ErrorCodeOf(err) != resellerclub.ErrorCodeInvalidOrderID {
+		t.Errorf("unknown order: got %v, want an invalid_order_id error", err)
+	}
+}
+
+func TestResponseShapes(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	orderID := s.AddOrder(Order{DomainName: "example.com", NS: []string{"ns1.example.net", "ns2.example.net"}})
+	s.AddOrder(Order{DomainName: "example.org"})
+
+	get := func(path string, params url.Values) (int, map[string]interface{}) {
+		t.Helper()
+
+		params.Set("auth-userid", s.UserID)
+		params.Set("api-key", s.APIKey)
+		resp, err := http.Get(s.URL + path + "?" + params.Encode())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		var res map[string]interface{}
+		if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+
+		return resp.StatusCode, res
+	}
+
+	// Numbers are strings.
+	_, details := get("/domains/details.json", url.Values{"order-id": {itoa(orderID)}, "options": {"OrderDetails"}})
+	for _, key := range []string{"orderid", "customerid", "endtime", "noOfNameServers"} {
+		if _, ok := details[key].(string); !ok {
+			t.Errorf("details: got %q %#v, want a string", key, details[key])
+		}
+	}
+
+	// Search results are keyed by their position on the page, next to the counts.
+	_, search := get("/domains/search.json", url.Values{"no-of-records": {"10"}, "page-no": {"1"}})
+	if search["recsonpage"] != "2" || search["recsindb"] != "2" {
+		t.Errorf("search: got recsonpage %#v and recsindb %#v", search["recsonpage"], search["recsindb"])
+	}
+	first, ok := search["1"].(map[string]interface{})
+	if !ok || first["orders.orderid"] != itoa(orderID) {
+		t.Errorf("search: got first result %#v", search["1"])
+	}
+	if _, ok = search["2"].(map[string]interface{}); !ok {
+		t.Errorf("search: got second result %#v", search["2"])
+	}
+
+	// The client decodes them into a slice with numbers.
+	items, err := s.Client().Domains.Search(&resellerclub.DomainSearchParams{NoOfRecords: 10, PageNo: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || int64(items[0].OrderID) != orderID {
+		t.Errorf("Search: got %+v, want order %v first of 2", items, orderID)
+	}
+
+	// Validation errors of write calls come as errorvalue, the others as status and message.
+	status, res := get("/domains/register.json", url.Values{"domain-name": {"example.com"}})
+	errorvalue, ok := res["errorvalue"].(map[string]interface{})
+	if status != http.StatusInternalServerError || !ok || errorvalue["error"] != "example.com is not available for registration" {
+		t.Errorf("register: got %v %#v", status, res)
+	}
+
+	status, res = get("/domains/details.json", url.Values{"order-id": {"1000"}, "options": {"All"}})
+	if status != http.StatusInternalServerError || res["status"] != "ERROR" || res["message"] != "Invalid Order ID: 1000" {
+		t.Errorf("details of an unknown order: got %v %#v", status, res)
+	}
+}
@@ -0,0 +1,210 @@
+package resellerclubtest
+
+import (
+	"strings"
+	"time"
+
+	"github.com/saulortega/resellerclub"
+)
+
+// Customer is a customer account of the fake API.
+type Customer struct {
+	ID       int64
+	Username string
+	Password string
+	Name     string
+	Company  string
+	Country  string
+	LangPref string
+}
+
+// Contact is a domain contact of the fake API.
+type Contact struct {
+	ID         int64
+	CustomerID int64
+	Type       string
+	Name       string
+	Company    string
+	Email      string
+	Address1   string
+	City       string
+	State      string
+	Country    string
+	Zip        string
+	TelNoCC    string
+	TelNo      string
+}
+
+// Order is a domain registration order of the fake API.
+type Order struct {
+	ID               int64
+	CustomerID       int64
+	DomainName       string
+	ProductKey       string
+	Status           string
+	NS               []string
+	RegContactID     int64
+	AdminContactID   int64
+	TechContactID    int64
+	BillingContactID int64
+	CreationTime     time.Time
+	EndTime          time.Time
+	AutoRenew        bool
+	PrivacyProtected bool
+	DomainSecret     string
+	ExtraAttrs       map[string]string
+
+	// Child Name Servers of the domain name, by host name.
+	CNS map[string][]string
+
+	// Whether the order is a Transfer waiting for authorization.
+	TransferPending bool
+
+	// New registrant contact of a change of registrant waiting for approval, if any.
+	PendingRegContactID int64
+}
+
+// AddCustomer adds a customer and returns its ID. The ID of c is ignored.
+func (s *Server) AddCustomer(c Customer) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c.ID = s.newID()
+	c.Username = strings.ToLower(c.Username)
+	s.customers[c.ID] = &c
+
+	return c.ID
+}
+
+// AddContact adds a contact and returns its ID. The ID of c is ignored.
+func (s *Server) AddContact(c Contact) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c.ID = s.newID()
+	if len(c.Type) == 0 {
+		c.Type = "Contact"
+	}
+	s.contacts[c.ID] = &c
+
+	return c.ID
+}
+
+// AddOrder adds a domain order, as if it had been registered, and returns its ID. The ID of o is ignored.
+func (s *Server) AddOrder(o Order) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o.ID = s.newID()
+	o.DomainName = strings.ToLower(o.DomainName)
+	if len(o.Status) == 0 {
+		o.Status = "Active"
+	}
+	if len(o.ProductKey) == 0 {
+		o.ProductKey = productKey(o.DomainName)
+	}
+	if o.CreationTime.IsZero() {
+		o.CreationTime = time.Now().Truncate(time.Second)
+	}
+	if o.EndTime.IsZero() {
+		o.EndTime = o.CreationTime.AddDate(1, 0, 0)
+	}
+	s.orders[o.ID] = &o
+
+	return o.ID
+}
+
+// SetAvailability sets the status answered by Domains.CheckAvailability for a domain name
+// without an order, e.g. resellerclub.DomainStatusRegisteredThroughOthers.
+func (s *Server) SetAvailability(domainName string, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.availability[strings.ToLower(domainName)] = status
+}
+
+// Customer returns a copy of the customer with the given ID.
+func (s *Server) Customer(id int64) (Customer, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.customers[id]
+	if !ok {
+		return Customer{}, false
+	}
+
+	return *c, true
+}
+
+// Order returns a copy of the order with the given ID.
+func (s *Server) Order(id int64) (Order, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.orders[id]
+	if !ok {
+		return Order{}, false
+	}
+
+	return *o, true
+}
+
+// ApproveRegistrantChange completes the pending change of registrant of an order,
+// as if the current and the new registrant had confirmed it. It reports whether there was one.
+func (s *Server) ApproveRegistrantChange(orderID int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.orders[orderID]
+	if !ok || o.PendingRegContactID == 0 {
+		return false
+	}
+
+	o.RegContactID = o.PendingRegContactID
+	o.PendingRegContactID = 0
+
+	return true
+}
+
+func (s *Server) newID() int64 {
+	s.nextID++
+	return s.nextID
+}
+
+func (s *Server) orderByDomain(domainName string) (*Order, bool) {
+	domainName = strings.ToLower(domainName)
+	for _, o := range s.orders {
+		if o.DomainName == domainName && o.Status != "Deleted" {
+			return o, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Server) domainStatus(domainName string) string {
+	if _, ok := s.orderByDomain(domainName); ok {
+		return resellerclub.DomainStatusRegisteredThroughUs
+	}
+
+	if status, ok := s.availability[strings.ToLower(domainName)]; ok {
+		return status
+	}
+
+	return resellerclub.DomainStatusAvailable
+}
+
+// productKey returns the product key of the TLD of domainName, as the API names them.
+func productKey(domainName string) string {
+	tld := domainName[strings.LastIndex(domainName, ".")+1:]
+	switch tld {
+	case "com":
+		return "domcno"
+	case "net":
+		return "dotnet"
+	case "org":
+		return "domorg"
+	}
+
+	return "dot" + tld
+}
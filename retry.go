@@ -0,0 +1,183 @@
+package resellerclub
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy defines how failed API calls are retried.
+//
+// Read-only calls (CheckAvailability, GetOrderID, GetOrderDetails, Search...) are retried
+// on network errors and on 429 and 5xx responses. Calls that modify data (Register, Renew,
+// Customers.Create...) are retried only when the connection could not be established,
+// since the request never reached the API, unless RetryNonIdempotent is set.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one. Values lower than 2 disable retries.
+	MaxAttempts int
+
+	// Backoff before the first retry. It doubles on every further retry, with jitter.
+	MinBackoff time.Duration
+
+	// Maximum backoff between attempts. It also caps the delay requested by a Retry-After header.
+	MaxBackoff time.Duration
+
+	// Whether calls that modify data are retried on any transient failure, like read-only calls.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy is the RetryPolicy used by a Client unless WithRetryPolicy is given.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  10 * time.Second,
+}
+
+// RetryEvent describes a failed attempt and the retry decision taken for it.
+type RetryEvent struct {
+	Method     string        // HTTP method of the call
+	Path       string        // API path of the call, e.g. /domains/details.json
+	Attempt    int           // Number of the failed attempt, starting at 1
+	StatusCode int           // HTTP status code of the response, 0 if there was no response
+	Err        error         // Network error of the attempt, if any
+	Retry      bool          // Whether the call will be retried
+	Wait       time.Duration // Time to wait before the next attempt
+}
+
+// WithRetryPolicy sets the RetryPolicy of the Client.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(client *Client) {
+		client.retryPolicy = policy
+	}
+}
+
+// WithoutRetries disables automatic retries.
+func WithoutRetries() Option {
+	return WithRetryPolicy(RetryPolicy{})
+}
+
+// WithRetryHook sets a function called after every failed attempt with the retry decision.
+func WithRetryHook(hook func(RetryEvent)) Option {
+	return func(client *Client) {
+		client.retryHook = hook
+	}
+}
+
+// decide tells whether a failed attempt must be retried and how long to wait before it.
+// The bool failed reports whether the attempt failed at all.
+func (policy RetryPolicy) decide(attempt int, idempotent bool, res *Response, err error) (failed bool, retry bool, wait time.Duration) {
+	switch {
+	case err != nil:
+		failed = true
+		retry = isDialError(err) || (idempotent || policy.RetryNonIdempotent) && isTransientError(err)
+	case isRetryableStatus(res.StatusCode):
+		// Errors reported by the API in the body are not transient, whatever the status code.
+		var apiErr Error
+		failed = true
+		retry = (idempotent || policy.RetryNonIdempotent) && !errors.As(res.Err, &apiErr)
+	default:
+		return false, false, 0
+	}
+
+	if !retry || attempt >= policy.MaxAttempts {
+		return failed, false, 0
+	}
+
+	wait = policy.backoff(attempt)
+	if res != nil {
+		if d, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+			wait = d
+			if policy.MaxBackoff > 0 && wait > policy.MaxBackoff {
+				wait = policy.MaxBackoff
+			}
+		}
+	}
+
+	return failed, true, wait
+}
+
+// backoff returns the exponential backoff with jitter before the attempt following the given one.
+func (policy RetryPolicy) backoff(attempt int) time.Duration {
+	d := policy.MinBackoff
+	for i := 1; i < attempt && (policy.MaxBackoff <= 0 || d < policy.MaxBackoff); i++ {
+		d *= 2
+	}
+	if policy.MaxBackoff > 0 && d > policy.MaxBackoff {
+		d = policy.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+func retryAfter(value string) (time.Duration, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(value); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// isDialError tells whether the connection could not be established, so the request was not sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
+
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,86 @@
+package resellerclub
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyDecide(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Second}
+	dialErr := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	unavailable := &Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	apiError := &Response{StatusCode: http.StatusInternalServerError, Header: http.Header{}, Err: newError("Invalid Order ID", nil)}
+
+	tests := []struct {
+		name       string
+		attempt    int
+		idempotent bool
+		res        *Response
+		err        error
+		failed     bool
+		retry      bool
+	}{
+		{"GET ok", 1, true, &Response{StatusCode: http.StatusOK}, nil, false, false},
+		{"GET 503", 1, true, unavailable, nil, true, true},
+		{"GET read error", 1, true, nil, io.ErrUnexpectedEOF, true, true},
+		{"GET last attempt", 3, true, unavailable, nil, true, false},
+		{"GET api error", 1, true, apiError, nil, true, false},
+		{"POST 503", 1, false, unavailable, nil, true, false},
+		{"POST read error", 1, false, nil, io.ErrUnexpectedEOF, true, false},
+		{"POST dial error", 1, false, nil, dialErr, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failed, retry, _ := policy.decide(tt.attempt, tt.idempotent, tt.res, tt.err)
+			if failed != tt.failed || retry != tt.retry {
+				t.Errorf("got failed=%v retry=%v, want failed=%v retry=%v", failed, retry, tt.failed, tt.retry)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDecideRetryNonIdempotent(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, RetryNonIdempotent: true}
+
+	_, retry, _ := policy.decide(1, false, &Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}, nil)
+	if !retry {
+		t.Error("POST 503 not retried with RetryNonIdempotent")
+	}
+}
+
+func TestRetryPolicyDecideRetryAfter(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Second}
+
+	tests := []struct {
+		retryAfter string
+		wait       time.Duration
+	}{
+		{"1", time.Second},
+		{"120", 2 * time.Second},
+		{time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		res := &Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {tt.retryAfter}}}
+
+		_, retry, wait := policy.decide(1, true, res, nil)
+		if !retry || wait != tt.wait {
+			t.Errorf("Retry-After %v: got retry=%v wait=%v, want wait=%v", tt.retryAfter, retry, wait, tt.wait)
+		}
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt := 1; attempt <= 10; attempt++ {
+		if d := policy.backoff(attempt); d < 0 || d > policy.MaxBackoff {
+			t.Errorf("attempt %v: backoff %v out of range", attempt, d)
+		}
+	}
+}